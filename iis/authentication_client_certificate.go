@@ -0,0 +1,144 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type ClientCertificateMappingAuthentication struct {
+	ID                       string             `json:"id"`
+	Enabled                  bool               `json:"enabled"`
+	OneToOneMappingsEnabled  bool               `json:"one_to_one_mappings_enabled"`
+	ManyToOneMappingsEnabled bool               `json:"many_to_one_mappings_enabled"`
+	Links                    ResourceReferences `json:"_links,omitempty"`
+}
+
+type OneToOneCertificateMapping struct {
+	ID                       string     `json:"id,omitempty"`
+	Enabled                  bool       `json:"enabled"`
+	Certificate              string     `json:"certificate"`
+	UserName                 string     `json:"user_name"`
+	Password                 string     `json:"password,omitempty"`
+	ClientCertificateMapping *Reference `json:"client_certificate_mapping,omitempty"`
+}
+
+type ManyToOneCertificateMapping struct {
+	ID                       string                            `json:"id,omitempty"`
+	Name                     string                            `json:"name"`
+	Description              string                            `json:"description"`
+	Enabled                  bool                              `json:"enabled"`
+	PermissionMode           string                            `json:"permission_mode"`
+	UserName                 string                            `json:"user_name"`
+	Password                 string                            `json:"password,omitempty"`
+	Rules                    []ManyToOneCertificateMappingRule `json:"rules"`
+	ClientCertificateMapping *Reference                        `json:"client_certificate_mapping,omitempty"`
+}
+
+type ManyToOneCertificateMappingRule struct {
+	CertificateField     string `json:"certificate_field"`
+	CertificateSubField  string `json:"certificate_sub_field"`
+	MatchCriteria        string `json:"match_criteria"`
+	CompareCaseSensitive bool   `json:"compare_case_sensitive"`
+}
+
+type OneToOneCertificateMappingListResponse struct {
+	Mappings []OneToOneCertificateMapping `json:"mappings"`
+}
+
+type ManyToOneCertificateMappingListResponse struct {
+	Mappings []ManyToOneCertificateMapping `json:"mappings"`
+}
+
+func (client Client) ReadClientCertificateMappingAuthentication(ctx context.Context, auth *Authentication) (*ClientCertificateMappingAuthentication, error) {
+	var mapping ClientCertificateMappingAuthentication
+	if err := getJson(ctx, client, auth.Links.ClientCertificateMapping.Href, &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func (client Client) ReadClientCertificateMappingAuthenticationFromId(ctx context.Context, id string) (*ClientCertificateMappingAuthentication, error) {
+	url := fmt.Sprintf("/api/webserver/authentication/client-certificate-mapping-authentication/%s", id)
+	var mapping ClientCertificateMappingAuthentication
+	if err := getJson(ctx, client, url, &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func (client Client) UpdateClientCertificateMappingAuthentication(ctx context.Context, auth *ClientCertificateMappingAuthentication) (*ClientCertificateMappingAuthentication, error) {
+	url := fmt.Sprintf("/api/webserver/authentication/client-certificate-mapping-authentication/%s", auth.ID)
+	res, err := httpPatch(ctx, client, url, auth)
+	if err != nil {
+		return nil, err
+	}
+	var mapping ClientCertificateMappingAuthentication
+	err = json.Unmarshal(res, &mapping)
+	if err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func (client Client) ListOneToOneCertificateMappings(ctx context.Context, auth *ClientCertificateMappingAuthentication) ([]OneToOneCertificateMapping, error) {
+	url, err := auth.Links.href("one_to_one")
+	if err != nil {
+		return nil, err
+	}
+	var res OneToOneCertificateMappingListResponse
+	if err := getJson(ctx, client, url, &res); err != nil {
+		return nil, err
+	}
+	return res.Mappings, nil
+}
+
+func (client Client) CreateOneToOneCertificateMapping(ctx context.Context, auth *ClientCertificateMappingAuthentication, mapping OneToOneCertificateMapping) (*OneToOneCertificateMapping, error) {
+	mapping.ClientCertificateMapping = &Reference{ID: auth.ID}
+	res, err := httpPost(ctx, client, "/api/webserver/authentication/client-certificate-mapping-authentication/one-to-one", mapping)
+	if err != nil {
+		return nil, err
+	}
+	var created OneToOneCertificateMapping
+	err = json.Unmarshal(res, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteOneToOneCertificateMapping(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/authentication/client-certificate-mapping-authentication/one-to-one/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListManyToOneCertificateMappings(ctx context.Context, auth *ClientCertificateMappingAuthentication) ([]ManyToOneCertificateMapping, error) {
+	url, err := auth.Links.href("many_to_one")
+	if err != nil {
+		return nil, err
+	}
+	var res ManyToOneCertificateMappingListResponse
+	if err := getJson(ctx, client, url, &res); err != nil {
+		return nil, err
+	}
+	return res.Mappings, nil
+}
+
+func (client Client) CreateManyToOneCertificateMapping(ctx context.Context, auth *ClientCertificateMappingAuthentication, mapping ManyToOneCertificateMapping) (*ManyToOneCertificateMapping, error) {
+	mapping.ClientCertificateMapping = &Reference{ID: auth.ID}
+	res, err := httpPost(ctx, client, "/api/webserver/authentication/client-certificate-mapping-authentication/many-to-one", mapping)
+	if err != nil {
+		return nil, err
+	}
+	var created ManyToOneCertificateMapping
+	err = json.Unmarshal(res, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteManyToOneCertificateMapping(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/authentication/client-certificate-mapping-authentication/many-to-one/%s", id)
+	return httpDelete(ctx, client, url)
+}
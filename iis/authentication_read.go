@@ -11,10 +11,11 @@ type Authentication struct {
 }
 
 type AuthenticationLinks struct {
-	Anonymous ResourceReference `json:"anonymous"`
-	Basic     ResourceReference `json:"basic"`
-	Digest    ResourceReference `json:"digest"`
-	Windows   ResourceReference `json:"windows"`
+	Anonymous                ResourceReference `json:"anonymous"`
+	Basic                    ResourceReference `json:"basic"`
+	Digest                   ResourceReference `json:"digest"`
+	Windows                  ResourceReference `json:"windows"`
+	ClientCertificateMapping ResourceReference `json:"client_certificate_mapping"`
 }
 
 func (client Client) ReadAuthentication(ctx context.Context, id string) (Authentication, error) {
@@ -38,6 +39,18 @@ func (client Client) ReadAuthenticationFromApplication(ctx context.Context, appl
 	return auth, nil
 }
 
+func (client Client) ReadAuthenticationFromScope(ctx context.Context, scope FeatureScope) (Authentication, error) {
+	var auth Authentication
+	url, err := client.readFeatureLink(ctx, scope, "authentication")
+	if err != nil {
+		return auth, err
+	}
+	if err := getJson(ctx, client, url, &auth); err != nil {
+		return auth, err
+	}
+	return auth, nil
+}
+
 func (client Client) ReadAnonymousAuthentication(ctx context.Context, auth *Authentication) (AnonymousAuthentication, error) {
 	var anonymous AnonymousAuthentication
 	if err := getJson(ctx, client, auth.Links.Anonymous.Href, &anonymous); err != nil {
@@ -0,0 +1,95 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Authorization struct {
+	ID     string             `json:"id"`
+	Bypass bool               `json:"bypass"`
+	Links  ResourceReferences `json:"_links,omitempty"`
+}
+
+type AuthorizationRule struct {
+	ID            string     `json:"id,omitempty"`
+	Users         string     `json:"users"`
+	Roles         string     `json:"roles"`
+	Verbs         []string   `json:"verbs"`
+	AccessType    string     `json:"access_type"`
+	Authorization *Reference `json:"authorization,omitempty"`
+}
+
+type AuthorizationRuleListResponse struct {
+	Rules []AuthorizationRule `json:"rules"`
+}
+
+func (client Client) ReadAuthorization(ctx context.Context, id string) (*Authorization, error) {
+	url := fmt.Sprintf("/api/webserver/authorization/%s", id)
+	var authorization Authorization
+	if err := getJson(ctx, client, url, &authorization); err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (client Client) ReadAuthorizationFromScope(ctx context.Context, scope FeatureScope) (*Authorization, error) {
+	url, err := client.readFeatureLink(ctx, scope, "authorization")
+	if err != nil {
+		return nil, err
+	}
+	var authorization Authorization
+	if err := getJson(ctx, client, url, &authorization); err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (client Client) UpdateAuthorization(ctx context.Context, id string, bypass bool) (*Authorization, error) {
+	reqBody := struct {
+		Bypass bool `json:"bypass"`
+	}{bypass}
+	url := fmt.Sprintf("/api/webserver/authorization/%s", id)
+	res, err := httpPatch(ctx, client, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	var authorization Authorization
+	err = json.Unmarshal(res, &authorization)
+	if err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (client Client) ListAuthorizationRules(ctx context.Context, authorization *Authorization) ([]AuthorizationRule, error) {
+	url, err := authorization.Links.href("rules")
+	if err != nil {
+		return nil, err
+	}
+	var res AuthorizationRuleListResponse
+	if err := getJson(ctx, client, url, &res); err != nil {
+		return nil, err
+	}
+	return res.Rules, nil
+}
+
+func (client Client) CreateAuthorizationRule(ctx context.Context, authorization *Authorization, rule AuthorizationRule) (*AuthorizationRule, error) {
+	rule.Authorization = &Reference{ID: authorization.ID}
+	res, err := httpPost(ctx, client, "/api/webserver/authorization/rules", rule)
+	if err != nil {
+		return nil, err
+	}
+	var created AuthorizationRule
+	err = json.Unmarshal(res, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteAuthorizationRule(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/authorization/rules/%s", id)
+	return httpDelete(ctx, client, url)
+}
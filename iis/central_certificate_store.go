@@ -0,0 +1,44 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CentralCertificateStore configures the file share https bindings load
+// their certificates from by hostname. Passwords are never returned.
+type CentralCertificateStore struct {
+	ID                 string                          `json:"id"`
+	Enabled            bool                            `json:"enabled"`
+	Path               string                          `json:"path"`
+	Identity           CentralCertificateStoreIdentity `json:"identity"`
+	PrivateKeyPassword string                          `json:"private_key_password,omitempty"`
+}
+
+type CentralCertificateStoreIdentity struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+func (client Client) ReadCentralCertificateStore(ctx context.Context) (*CentralCertificateStore, error) {
+	var store CentralCertificateStore
+	if err := getJson(ctx, client, "/api/webserver/centralized-certificates", &store); err != nil {
+		return nil, err
+	}
+	return &store, nil
+}
+
+func (client Client) UpdateCentralCertificateStore(ctx context.Context, store *CentralCertificateStore) (*CentralCertificateStore, error) {
+	url := fmt.Sprintf("/api/webserver/centralized-certificates/%s", store.ID)
+	res, err := httpPatch(ctx, client, url, store)
+	if err != nil {
+		return nil, err
+	}
+	var updated CentralCertificateStore
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,54 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Certificate struct {
+	ID                      string           `json:"id"`
+	Name                    string           `json:"name"`
+	Alias                   string           `json:"alias"`
+	FriendlyName            string           `json:"friendly_name"`
+	Subject                 string           `json:"subject"`
+	IssuedBy                string           `json:"issued_by"`
+	Thumbprint              string           `json:"thumbprint"`
+	ValidFrom               time.Time        `json:"valid_from"`
+	ValidTo                 time.Time        `json:"valid_to"`
+	IntendedPurposes        []string         `json:"intended_purposes"`
+	SubjectAlternativeNames []string         `json:"subject_alternative_names"`
+	Store                   CertificateStore `json:"store"`
+}
+
+type CertificateStore struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CertificateReference points https bindings to their certificate.
+type CertificateReference struct {
+	ID         string `json:"id,omitempty"`
+	Thumbprint string `json:"thumbprint,omitempty"`
+}
+
+type CertificateListResponse struct {
+	Certificates []Certificate `json:"certificates"`
+}
+
+func (client Client) ListCertificates(ctx context.Context) ([]Certificate, error) {
+	var res CertificateListResponse
+	if err := getJson(ctx, client, "/api/certificates?fields=*", &res); err != nil {
+		return nil, err
+	}
+	return res.Certificates, nil
+}
+
+func (client Client) ReadCertificate(ctx context.Context, id string) (*Certificate, error) {
+	url := fmt.Sprintf("/api/certificates/%s", id)
+	var certificate Certificate
+	if err := getJson(ctx, client, url, &certificate); err != nil {
+		return nil, err
+	}
+	return &certificate, nil
+}
@@ -0,0 +1,95 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type DefaultDocument struct {
+	ID      string             `json:"id"`
+	Enabled bool               `json:"enabled"`
+	Links   ResourceReferences `json:"_links,omitempty"`
+}
+
+type DefaultDocumentFile struct {
+	ID              string     `json:"id,omitempty"`
+	Name            string     `json:"name"`
+	DefaultDocument *Reference `json:"default_document,omitempty"`
+}
+
+type DefaultDocumentFileListResponse struct {
+	Files []DefaultDocumentFile `json:"files"`
+}
+
+func (client Client) ReadDefaultDocument(ctx context.Context, id string) (*DefaultDocument, error) {
+	url := fmt.Sprintf("/api/webserver/default-documents/%s", id)
+	var document DefaultDocument
+	if err := getJson(ctx, client, url, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (client Client) ReadDefaultDocumentFromScope(ctx context.Context, scope FeatureScope) (*DefaultDocument, error) {
+	url, err := client.readFeatureLink(ctx, scope, "default_document")
+	if err != nil {
+		return nil, err
+	}
+	var document DefaultDocument
+	if err := getJson(ctx, client, url, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (client Client) UpdateDefaultDocument(ctx context.Context, id string, enabled bool) (*DefaultDocument, error) {
+	reqBody := struct {
+		Enabled bool `json:"enabled"`
+	}{enabled}
+	url := fmt.Sprintf("/api/webserver/default-documents/%s", id)
+	res, err := httpPatch(ctx, client, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	var document DefaultDocument
+	err = json.Unmarshal(res, &document)
+	if err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (client Client) ListDefaultDocumentFiles(ctx context.Context, document *DefaultDocument) ([]DefaultDocumentFile, error) {
+	url, err := document.Links.href("files")
+	if err != nil {
+		return nil, err
+	}
+	var res DefaultDocumentFileListResponse
+	if err := getJson(ctx, client, url, &res); err != nil {
+		return nil, err
+	}
+	return res.Files, nil
+}
+
+func (client Client) CreateDefaultDocumentFile(ctx context.Context, document *DefaultDocument, name string) (*DefaultDocumentFile, error) {
+	reqBody := DefaultDocumentFile{
+		Name:            name,
+		DefaultDocument: &Reference{ID: document.ID},
+	}
+	res, err := httpPost(ctx, client, "/api/webserver/default-documents/files", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	var file DefaultDocumentFile
+	err = json.Unmarshal(res, &file)
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+func (client Client) DeleteDefaultDocumentFile(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/default-documents/files/%s", id)
+	return httpDelete(ctx, client, url)
+}
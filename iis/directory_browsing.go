@@ -0,0 +1,56 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type DirectoryBrowsing struct {
+	ID                string                      `json:"id"`
+	Enabled           bool                        `json:"enabled"`
+	AllowedAttributes DirectoryBrowsingAttributes `json:"allowed_attributes"`
+}
+
+type DirectoryBrowsingAttributes struct {
+	Date      bool `json:"date"`
+	Time      bool `json:"time"`
+	Size      bool `json:"size"`
+	Extension bool `json:"extension"`
+	LongDate  bool `json:"long_date"`
+}
+
+func (client Client) ReadDirectoryBrowsing(ctx context.Context, id string) (*DirectoryBrowsing, error) {
+	url := fmt.Sprintf("/api/webserver/directory-browsing/%s", id)
+	var browsing DirectoryBrowsing
+	if err := getJson(ctx, client, url, &browsing); err != nil {
+		return nil, err
+	}
+	return &browsing, nil
+}
+
+func (client Client) ReadDirectoryBrowsingFromScope(ctx context.Context, scope FeatureScope) (*DirectoryBrowsing, error) {
+	url, err := client.readFeatureLink(ctx, scope, "directory_browsing")
+	if err != nil {
+		return nil, err
+	}
+	var browsing DirectoryBrowsing
+	if err := getJson(ctx, client, url, &browsing); err != nil {
+		return nil, err
+	}
+	return &browsing, nil
+}
+
+func (client Client) UpdateDirectoryBrowsing(ctx context.Context, browsing *DirectoryBrowsing) (*DirectoryBrowsing, error) {
+	url := fmt.Sprintf("/api/webserver/directory-browsing/%s", browsing.ID)
+	res, err := httpPatch(ctx, client, url, browsing)
+	if err != nil {
+		return nil, err
+	}
+	var updated DirectoryBrowsing
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,53 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+// FeatureScope selects the configuration level a feature is read from.
+// At most one of Application or Website should be set, leaving both empty
+// selects the web server itself.
+type FeatureScope struct {
+	Application string
+	Website     string
+}
+
+func (links ResourceReferences) href(rel string) (string, error) {
+	link, ok := links[rel]
+	if !ok || link == nil {
+		return "", fmt.Errorf("resource has no %s link", rel)
+	}
+	return link.Href, nil
+}
+
+func (client Client) readFeatureLink(ctx context.Context, scope FeatureScope, rel string) (string, error) {
+	switch {
+	case scope.Application != "":
+		application, err := client.ReadApplication(ctx, scope.Application)
+		if err != nil {
+			return "", err
+		}
+		return application.Links.href(rel)
+	case scope.Website != "":
+		site, err := client.ReadWebsite(ctx, scope.Website)
+		if err != nil {
+			return "", err
+		}
+		return site.Links.href(rel)
+	default:
+		server, err := client.ReadWebServer(ctx)
+		if err != nil {
+			return "", err
+		}
+		return server.Links.href(rel)
+	}
+}
+
+func (client Client) readFeatureCollection(ctx context.Context, links ResourceReferences, rel string, r interface{}) error {
+	url, err := links.href(rel)
+	if err != nil {
+		return err
+	}
+	return getJson(ctx, client, url, r)
+}
@@ -0,0 +1,119 @@
+package iis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// File is a file or directory below one of the locations the IIS
+// Administration API is allowed to access.
+type File struct {
+	ID           string             `json:"id,omitempty"`
+	Name         string             `json:"name"`
+	Type         string             `json:"type"`
+	PhysicalPath string             `json:"physical_path,omitempty"`
+	Exists       bool               `json:"exists,omitempty"`
+	Size         int64              `json:"size,omitempty"`
+	Parent       *Reference         `json:"parent,omitempty"`
+	Links        ResourceReferences `json:"_links,omitempty"`
+}
+
+const FileTypeFile = "file"
+const FileTypeDirectory = "directory"
+
+func (client Client) ReadFile(ctx context.Context, id string) (*File, error) {
+	url := fmt.Sprintf("/api/files/%s", id)
+	var file File
+	if err := getJson(ctx, client, url, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// FindFile looks up a file or directory by its physical path. It returns nil
+// without an error if nothing exists at the path.
+func (client Client) FindFile(ctx context.Context, physicalPath string) (*File, error) {
+	path := "/api/files?physical_path=" + url.QueryEscape(physicalPath)
+	req, err := buildRequest(ctx, client, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode == http.StatusNotFound {
+		_ = response.Body.Close()
+		return nil, nil
+	}
+	if err := guardStatusCode(req.Method, req.URL, response); err != nil {
+		return nil, err
+	}
+	body, err := fetchBody(response)
+	if err != nil {
+		return nil, err
+	}
+	var file File
+	if err := json.Unmarshal(body, &file); err != nil {
+		return nil, err
+	}
+	if file.ID == "" || !file.Exists {
+		return nil, nil
+	}
+	return &file, nil
+}
+
+func (client Client) ListFiles(ctx context.Context, directory *File) ([]File, error) {
+	var res struct {
+		Files []File `json:"files"`
+	}
+	if err := client.readFeatureCollection(ctx, directory.Links, "files", &res); err != nil {
+		return nil, err
+	}
+	return res.Files, nil
+}
+
+func (client Client) CreateFile(ctx context.Context, parent *File, name, fileType string) (*File, error) {
+	request := File{
+		Name:   name,
+		Type:   fileType,
+		Parent: &Reference{ID: parent.ID},
+	}
+	var created File
+	if err := postJson(ctx, client, "/api/files", request, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteFile(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/files/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ReadFileContent(ctx context.Context, id string) ([]byte, error) {
+	url := fmt.Sprintf("/api/files/content/%s", id)
+	return httpGet(ctx, client, url)
+}
+
+func (client Client) WriteFileContent(ctx context.Context, id string, content []byte) error {
+	url := fmt.Sprintf("/api/files/content/%s", id)
+	req, err := buildRequest(ctx, client, "PUT", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(content))
+	req.ContentLength = int64(len(content))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	response, err := executeRequest(client, req)
+	if err != nil {
+		return err
+	}
+	_, err = fetchBody(response)
+	return err
+}
@@ -0,0 +1,105 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Handlers struct {
+	ID           string             `json:"id"`
+	AccessPolicy AccessPolicy       `json:"access_policy"`
+	Links        ResourceReferences `json:"_links,omitempty"`
+}
+
+type AccessPolicy struct {
+	Read    bool `json:"read"`
+	Script  bool `json:"script"`
+	Execute bool `json:"execute"`
+}
+
+type HandlerMapping struct {
+	ID              string     `json:"id,omitempty"`
+	Name            string     `json:"name"`
+	Path            string     `json:"path"`
+	Verbs           string     `json:"verbs"`
+	Type            string     `json:"type"`
+	Modules         string     `json:"modules"`
+	ScriptProcessor string     `json:"script_processor"`
+	ResourceType    string     `json:"resource_type"`
+	RequireAccess   string     `json:"require_access"`
+	Precondition    string     `json:"precondition"`
+	Handler         *Reference `json:"handler,omitempty"`
+}
+
+func (client Client) ReadHandlers(ctx context.Context, id string) (*Handlers, error) {
+	url := fmt.Sprintf("/api/webserver/http-handlers/%s", id)
+	var handlers Handlers
+	if err := getJson(ctx, client, url, &handlers); err != nil {
+		return nil, err
+	}
+	return &handlers, nil
+}
+
+func (client Client) ReadHandlersFromScope(ctx context.Context, scope FeatureScope) (*Handlers, error) {
+	url, err := client.readFeatureLink(ctx, scope, "handlers")
+	if err != nil {
+		return nil, err
+	}
+	var handlers Handlers
+	if err := getJson(ctx, client, url, &handlers); err != nil {
+		return nil, err
+	}
+	return &handlers, nil
+}
+
+func (client Client) UpdateHandlers(ctx context.Context, handlers *Handlers) (*Handlers, error) {
+	url := fmt.Sprintf("/api/webserver/http-handlers/%s", handlers.ID)
+	res, err := httpPatch(ctx, client, url, handlers)
+	if err != nil {
+		return nil, err
+	}
+	var updated Handlers
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) ReadHandlerMapping(ctx context.Context, id string) (*HandlerMapping, error) {
+	url := fmt.Sprintf("/api/webserver/http-handlers/entries/%s", id)
+	var mapping HandlerMapping
+	if err := getJson(ctx, client, url, &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+func (client Client) CreateHandlerMapping(ctx context.Context, handlers *Handlers, mapping HandlerMapping) (*HandlerMapping, error) {
+	mapping.Handler = &Reference{ID: handlers.ID}
+	var created HandlerMapping
+	if err := postJson(ctx, client, "/api/webserver/http-handlers/entries", mapping, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateHandlerMapping(ctx context.Context, mapping *HandlerMapping) (*HandlerMapping, error) {
+	url := fmt.Sprintf("/api/webserver/http-handlers/entries/%s", mapping.ID)
+	res, err := httpPatch(ctx, client, url, mapping)
+	if err != nil {
+		return nil, err
+	}
+	var updated HandlerMapping
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteHandlerMapping(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-handlers/entries/%s", id)
+	return httpDelete(ctx, client, url)
+}
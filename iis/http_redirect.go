@@ -0,0 +1,51 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type HttpRedirect struct {
+	ID               string `json:"id"`
+	Enabled          bool   `json:"enabled"`
+	Destination      string `json:"destination"`
+	Absolute         bool   `json:"absolute"`
+	PreserveFilename bool   `json:"preserve_filename"`
+	StatusCode       int    `json:"status_code"`
+}
+
+func (client Client) ReadHttpRedirect(ctx context.Context, id string) (*HttpRedirect, error) {
+	url := fmt.Sprintf("/api/webserver/http-redirect/%s", id)
+	var redirect HttpRedirect
+	if err := getJson(ctx, client, url, &redirect); err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+func (client Client) ReadHttpRedirectFromScope(ctx context.Context, scope FeatureScope) (*HttpRedirect, error) {
+	url, err := client.readFeatureLink(ctx, scope, "http_redirect")
+	if err != nil {
+		return nil, err
+	}
+	var redirect HttpRedirect
+	if err := getJson(ctx, client, url, &redirect); err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+func (client Client) UpdateHttpRedirect(ctx context.Context, redirect *HttpRedirect) (*HttpRedirect, error) {
+	url := fmt.Sprintf("/api/webserver/http-redirect/%s", redirect.ID)
+	res, err := httpPatch(ctx, client, url, redirect)
+	if err != nil {
+		return nil, err
+	}
+	var updated HttpRedirect
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,95 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type IpRestrictions struct {
+	ID               string             `json:"id"`
+	Enabled          bool               `json:"enabled"`
+	AllowUnlisted    bool               `json:"allow_unlisted"`
+	EnableReverseDns bool               `json:"enable_reverse_dns"`
+	EnableProxyMode  bool               `json:"enable_proxy_mode"`
+	DenyAction       string             `json:"deny_action"`
+	Links            ResourceReferences `json:"_links,omitempty"`
+}
+
+type IpRestrictionEntry struct {
+	ID            string     `json:"id,omitempty"`
+	IPAddress     string     `json:"ip_address"`
+	SubnetMask    string     `json:"subnet_mask"`
+	Allowed       bool       `json:"allowed"`
+	IpRestriction *Reference `json:"ip_restriction,omitempty"`
+}
+
+type IpRestrictionEntryListResponse struct {
+	Entries []IpRestrictionEntry `json:"entries"`
+}
+
+func (client Client) ReadIpRestrictions(ctx context.Context, id string) (*IpRestrictions, error) {
+	url := fmt.Sprintf("/api/webserver/ip-restrictions/%s", id)
+	var restrictions IpRestrictions
+	if err := getJson(ctx, client, url, &restrictions); err != nil {
+		return nil, err
+	}
+	return &restrictions, nil
+}
+
+func (client Client) ReadIpRestrictionsFromScope(ctx context.Context, scope FeatureScope) (*IpRestrictions, error) {
+	url, err := client.readFeatureLink(ctx, scope, "ip_restrictions")
+	if err != nil {
+		return nil, err
+	}
+	var restrictions IpRestrictions
+	if err := getJson(ctx, client, url, &restrictions); err != nil {
+		return nil, err
+	}
+	return &restrictions, nil
+}
+
+func (client Client) UpdateIpRestrictions(ctx context.Context, restrictions *IpRestrictions) (*IpRestrictions, error) {
+	url := fmt.Sprintf("/api/webserver/ip-restrictions/%s", restrictions.ID)
+	res, err := httpPatch(ctx, client, url, restrictions)
+	if err != nil {
+		return nil, err
+	}
+	var updated IpRestrictions
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) ListIpRestrictionEntries(ctx context.Context, restrictions *IpRestrictions) ([]IpRestrictionEntry, error) {
+	url, err := restrictions.Links.href("entries")
+	if err != nil {
+		return nil, err
+	}
+	var res IpRestrictionEntryListResponse
+	if err := getJson(ctx, client, url, &res); err != nil {
+		return nil, err
+	}
+	return res.Entries, nil
+}
+
+func (client Client) CreateIpRestrictionEntry(ctx context.Context, restrictions *IpRestrictions, entry IpRestrictionEntry) (*IpRestrictionEntry, error) {
+	entry.IpRestriction = &Reference{ID: restrictions.ID}
+	res, err := httpPost(ctx, client, "/api/webserver/ip-restrictions/entries", entry)
+	if err != nil {
+		return nil, err
+	}
+	var created IpRestrictionEntry
+	err = json.Unmarshal(res, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteIpRestrictionEntry(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/ip-restrictions/entries/%s", id)
+	return httpDelete(ctx, client, url)
+}
@@ -0,0 +1,65 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Logging struct {
+	ID              string           `json:"id"`
+	Enabled         bool             `json:"enabled"`
+	LogPerSite      bool             `json:"log_per_site"`
+	Directory       string           `json:"directory"`
+	LogFileFormat   string           `json:"log_file_format"`
+	Rollover        LogRollover      `json:"rollover"`
+	LogFields       map[string]bool  `json:"log_fields"`
+	CustomLogFields []CustomLogField `json:"custom_log_fields"`
+}
+
+type LogRollover struct {
+	Period       string `json:"period"`
+	TruncateSize int64  `json:"truncate_size"`
+	UseLocalTime bool   `json:"use_local_time"`
+}
+
+type CustomLogField struct {
+	FieldName  string `json:"field_name"`
+	SourceName string `json:"source_name"`
+	SourceType string `json:"source_type"`
+}
+
+func (client Client) ReadLogging(ctx context.Context, id string) (*Logging, error) {
+	url := fmt.Sprintf("/api/webserver/logging/%s", id)
+	var logging Logging
+	if err := getJson(ctx, client, url, &logging); err != nil {
+		return nil, err
+	}
+	return &logging, nil
+}
+
+func (client Client) ReadLoggingFromScope(ctx context.Context, scope FeatureScope) (*Logging, error) {
+	url, err := client.readFeatureLink(ctx, scope, "logging")
+	if err != nil {
+		return nil, err
+	}
+	var logging Logging
+	if err := getJson(ctx, client, url, &logging); err != nil {
+		return nil, err
+	}
+	return &logging, nil
+}
+
+func (client Client) UpdateLogging(ctx context.Context, logging *Logging) (*Logging, error) {
+	url := fmt.Sprintf("/api/webserver/logging/%s", logging.ID)
+	res, err := httpPatch(ctx, client, url, logging)
+	if err != nil {
+		return nil, err
+	}
+	var updated Logging
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,135 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+)
+
+type Modules struct {
+	ID    string             `json:"id"`
+	Links ResourceReferences `json:"_links,omitempty"`
+}
+
+type ModuleEntry struct {
+	ID           string     `json:"id,omitempty"`
+	Name         string     `json:"name"`
+	Type         string     `json:"type"`
+	Precondition string     `json:"precondition"`
+	Modules      *Reference `json:"modules,omitempty"`
+}
+
+type GlobalModule struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	Precondition string `json:"precondition"`
+}
+
+func (client Client) ReadModulesFromScope(ctx context.Context, scope FeatureScope) (*Modules, error) {
+	url, err := client.readFeatureLink(ctx, scope, "modules")
+	if err != nil {
+		return nil, err
+	}
+	var modules Modules
+	if err := getJson(ctx, client, url, &modules); err != nil {
+		return nil, err
+	}
+	return &modules, nil
+}
+
+func (client Client) ReadModuleEntry(ctx context.Context, id string) (*ModuleEntry, error) {
+	url := fmt.Sprintf("/api/webserver/http-modules/entries/%s", id)
+	var entry ModuleEntry
+	if err := getJson(ctx, client, url, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (client Client) CreateModuleEntry(ctx context.Context, modules *Modules, entry ModuleEntry) (*ModuleEntry, error) {
+	entry.Modules = &Reference{ID: modules.ID}
+	var created ModuleEntry
+	if err := postJson(ctx, client, "/api/webserver/http-modules/entries", entry, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteModuleEntry(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-modules/entries/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+// globalModulesUrl resolves the global modules collection through the links of
+// the web server's modules feature, optionally addressing a single module.
+func (client Client) globalModulesUrl(ctx context.Context, id string) (string, error) {
+	modules, err := client.ReadModulesFromScope(ctx, FeatureScope{})
+	if err != nil {
+		return "", err
+	}
+	href, err := modules.Links.href("global_modules")
+	if err != nil {
+		return "", err
+	}
+	collection, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	if id != "" {
+		collection.Path = path.Join(collection.Path, id)
+		collection.RawQuery = ""
+	}
+	return collection.String(), nil
+}
+
+func (client Client) ReadGlobalModule(ctx context.Context, id string) (*GlobalModule, error) {
+	url, err := client.globalModulesUrl(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var module GlobalModule
+	if err := getJson(ctx, client, url, &module); err != nil {
+		return nil, err
+	}
+	return &module, nil
+}
+
+func (client Client) CreateGlobalModule(ctx context.Context, module GlobalModule) (*GlobalModule, error) {
+	url, err := client.globalModulesUrl(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var created GlobalModule
+	if err := postJson(ctx, client, url, module, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateGlobalModule(ctx context.Context, module *GlobalModule) (*GlobalModule, error) {
+	url, err := client.globalModulesUrl(ctx, module.ID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := httpPatch(ctx, client, url, module)
+	if err != nil {
+		return nil, err
+	}
+	var updated GlobalModule
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteGlobalModule(ctx context.Context, id string) error {
+	url, err := client.globalModulesUrl(ctx, id)
+	if err != nil {
+		return err
+	}
+	return httpDelete(ctx, client, url)
+}
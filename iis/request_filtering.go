@@ -0,0 +1,241 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type RequestFiltering struct {
+	ID                     string             `json:"id"`
+	AllowDoubleEscaping    bool               `json:"allow_double_escaping"`
+	AllowHighBitCharacters bool               `json:"allow_high_bit_characters"`
+	MaxContentLength       int64              `json:"max_content_length"`
+	MaxUrlLength           int64              `json:"max_url_length"`
+	MaxQueryStringLength   int64              `json:"max_query_string_length"`
+	Links                  ResourceReferences `json:"_links,omitempty"`
+}
+
+type FileNameExtension struct {
+	ID               string     `json:"id,omitempty"`
+	Extension        string     `json:"extension"`
+	Allow            bool       `json:"allow"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type HiddenSegment struct {
+	ID               string     `json:"id,omitempty"`
+	Segment          string     `json:"segment"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type UrlSequence struct {
+	ID               string     `json:"id,omitempty"`
+	Url              string     `json:"url"`
+	Allow            bool       `json:"allow"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type QueryStringSequence struct {
+	ID               string     `json:"id,omitempty"`
+	QueryString      string     `json:"query_string"`
+	Allow            bool       `json:"allow"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type HeaderLimit struct {
+	ID               string     `json:"id,omitempty"`
+	Header           string     `json:"header"`
+	SizeLimit        int64      `json:"size_limit"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type RequestFilteringRule struct {
+	ID               string     `json:"id,omitempty"`
+	Name             string     `json:"name"`
+	ScanUrl          bool       `json:"scan_url"`
+	ScanQueryString  bool       `json:"scan_query_string"`
+	Headers          []string   `json:"headers"`
+	AppliesTo        []string   `json:"applies_to"`
+	DenyStrings      []string   `json:"deny_strings"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+func (client Client) ReadRequestFiltering(ctx context.Context, id string) (*RequestFiltering, error) {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/%s", id)
+	var filtering RequestFiltering
+	if err := getJson(ctx, client, url, &filtering); err != nil {
+		return nil, err
+	}
+	return &filtering, nil
+}
+
+func (client Client) ReadRequestFilteringFromScope(ctx context.Context, scope FeatureScope) (*RequestFiltering, error) {
+	url, err := client.readFeatureLink(ctx, scope, "request_filtering")
+	if err != nil {
+		return nil, err
+	}
+	var filtering RequestFiltering
+	if err := getJson(ctx, client, url, &filtering); err != nil {
+		return nil, err
+	}
+	return &filtering, nil
+}
+
+func (client Client) UpdateRequestFiltering(ctx context.Context, filtering *RequestFiltering) (*RequestFiltering, error) {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/%s", filtering.ID)
+	res, err := httpPatch(ctx, client, url, filtering)
+	if err != nil {
+		return nil, err
+	}
+	var updated RequestFiltering
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) ListFileNameExtensions(ctx context.Context, filtering *RequestFiltering) ([]FileNameExtension, error) {
+	var res struct {
+		FileExtensions []FileNameExtension `json:"file_extensions"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "file_extensions", &res); err != nil {
+		return nil, err
+	}
+	return res.FileExtensions, nil
+}
+
+func (client Client) CreateFileNameExtension(ctx context.Context, filtering *RequestFiltering, extension FileNameExtension) (*FileNameExtension, error) {
+	extension.RequestFiltering = &Reference{ID: filtering.ID}
+	var created FileNameExtension
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/file-name-extensions", extension, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteFileNameExtension(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/file-name-extensions/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListHiddenSegments(ctx context.Context, filtering *RequestFiltering) ([]HiddenSegment, error) {
+	var res struct {
+		HiddenSegments []HiddenSegment `json:"hidden_segments"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "hidden_segments", &res); err != nil {
+		return nil, err
+	}
+	return res.HiddenSegments, nil
+}
+
+func (client Client) CreateHiddenSegment(ctx context.Context, filtering *RequestFiltering, segment HiddenSegment) (*HiddenSegment, error) {
+	segment.RequestFiltering = &Reference{ID: filtering.ID}
+	var created HiddenSegment
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/hidden-segments", segment, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteHiddenSegment(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/hidden-segments/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListUrlSequences(ctx context.Context, filtering *RequestFiltering) ([]UrlSequence, error) {
+	var res struct {
+		Urls []UrlSequence `json:"urls"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "urls", &res); err != nil {
+		return nil, err
+	}
+	return res.Urls, nil
+}
+
+func (client Client) CreateUrlSequence(ctx context.Context, filtering *RequestFiltering, sequence UrlSequence) (*UrlSequence, error) {
+	sequence.RequestFiltering = &Reference{ID: filtering.ID}
+	var created UrlSequence
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/urls", sequence, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteUrlSequence(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/urls/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListQueryStringSequences(ctx context.Context, filtering *RequestFiltering) ([]QueryStringSequence, error) {
+	var res struct {
+		QueryStrings []QueryStringSequence `json:"query_strings"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "query_strings", &res); err != nil {
+		return nil, err
+	}
+	return res.QueryStrings, nil
+}
+
+func (client Client) CreateQueryStringSequence(ctx context.Context, filtering *RequestFiltering, sequence QueryStringSequence) (*QueryStringSequence, error) {
+	sequence.RequestFiltering = &Reference{ID: filtering.ID}
+	var created QueryStringSequence
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/query-strings", sequence, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteQueryStringSequence(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/query-strings/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListHeaderLimits(ctx context.Context, filtering *RequestFiltering) ([]HeaderLimit, error) {
+	var res struct {
+		HeaderLimits []HeaderLimit `json:"header_limits"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "header_limits", &res); err != nil {
+		return nil, err
+	}
+	return res.HeaderLimits, nil
+}
+
+func (client Client) CreateHeaderLimit(ctx context.Context, filtering *RequestFiltering, limit HeaderLimit) (*HeaderLimit, error) {
+	limit.RequestFiltering = &Reference{ID: filtering.ID}
+	var created HeaderLimit
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/header-limits", limit, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteHeaderLimit(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/header-limits/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListRequestFilteringRules(ctx context.Context, filtering *RequestFiltering) ([]RequestFilteringRule, error) {
+	var res struct {
+		Rules []RequestFilteringRule `json:"rules"`
+	}
+	if err := client.readFeatureCollection(ctx, filtering.Links, "rules", &res); err != nil {
+		return nil, err
+	}
+	return res.Rules, nil
+}
+
+func (client Client) CreateRequestFilteringRule(ctx context.Context, filtering *RequestFiltering, rule RequestFilteringRule) (*RequestFilteringRule, error) {
+	rule.RequestFiltering = &Reference{ID: filtering.ID}
+	var created RequestFilteringRule
+	if err := postJson(ctx, client, "/api/webserver/http-request-filtering/rules", rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteRequestFilteringRule(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-filtering/rules/%s", id)
+	return httpDelete(ctx, client, url)
+}
@@ -0,0 +1,112 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type RequestTracing struct {
+	ID                      string             `json:"id"`
+	Enabled                 bool               `json:"enabled"`
+	Directory               string             `json:"directory"`
+	MaximumNumberTraceFiles int64              `json:"maximum_number_trace_files"`
+	Links                   ResourceReferences `json:"_links,omitempty"`
+}
+
+type TraceProvider struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Guid  string   `json:"guid"`
+	Areas []string `json:"areas"`
+}
+
+type TraceRule struct {
+	ID                      string       `json:"id,omitempty"`
+	Path                    string       `json:"path"`
+	StatusCodes             []string     `json:"status_codes"`
+	MinRequestExecutionTime int64        `json:"min_request_execution_time"`
+	EventSeverity           string       `json:"event_severity"`
+	Traces                  []TraceEntry `json:"traces"`
+	RequestTracing          *Reference   `json:"request_tracing,omitempty"`
+}
+
+type TraceEntry struct {
+	AllowedAreas map[string]bool   `json:"allowed_areas"`
+	Provider     ProviderReference `json:"provider"`
+	Verbosity    string            `json:"verbosity"`
+}
+
+type ProviderReference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func (client Client) ReadRequestTracing(ctx context.Context, id string) (*RequestTracing, error) {
+	url := fmt.Sprintf("/api/webserver/http-request-tracing/%s", id)
+	var tracing RequestTracing
+	if err := getJson(ctx, client, url, &tracing); err != nil {
+		return nil, err
+	}
+	return &tracing, nil
+}
+
+func (client Client) ReadRequestTracingFromScope(ctx context.Context, scope FeatureScope) (*RequestTracing, error) {
+	url, err := client.readFeatureLink(ctx, scope, "request_tracing")
+	if err != nil {
+		return nil, err
+	}
+	var tracing RequestTracing
+	if err := getJson(ctx, client, url, &tracing); err != nil {
+		return nil, err
+	}
+	return &tracing, nil
+}
+
+func (client Client) UpdateRequestTracing(ctx context.Context, tracing *RequestTracing) (*RequestTracing, error) {
+	url := fmt.Sprintf("/api/webserver/http-request-tracing/%s", tracing.ID)
+	res, err := httpPatch(ctx, client, url, tracing)
+	if err != nil {
+		return nil, err
+	}
+	var updated RequestTracing
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) ListTraceProviders(ctx context.Context, tracing *RequestTracing) ([]TraceProvider, error) {
+	var res struct {
+		Providers []TraceProvider `json:"providers"`
+	}
+	if err := client.readFeatureCollection(ctx, tracing.Links, "providers", &res); err != nil {
+		return nil, err
+	}
+	return res.Providers, nil
+}
+
+func (client Client) ListTraceRules(ctx context.Context, tracing *RequestTracing) ([]TraceRule, error) {
+	var res struct {
+		Rules []TraceRule `json:"rules"`
+	}
+	if err := client.readFeatureCollection(ctx, tracing.Links, "rules", &res); err != nil {
+		return nil, err
+	}
+	return res.Rules, nil
+}
+
+func (client Client) CreateTraceRule(ctx context.Context, tracing *RequestTracing, rule TraceRule) (*TraceRule, error) {
+	rule.RequestTracing = &Reference{ID: tracing.ID}
+	var created TraceRule
+	if err := postJson(ctx, client, "/api/webserver/http-request-tracing/rules", rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteTraceRule(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-request-tracing/rules/%s", id)
+	return httpDelete(ctx, client, url)
+}
@@ -0,0 +1,71 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type ResponseCompression struct {
+	ID                                string `json:"id"`
+	DoStaticCompression               bool   `json:"do_static_compression"`
+	DoDynamicCompression              bool   `json:"do_dynamic_compression"`
+	Directory                         string `json:"directory"`
+	DoDiskSpaceLimiting               bool   `json:"do_disk_space_limitting"`
+	MaxDiskSpaceUsage                 int64  `json:"max_disk_space_usage"`
+	MinFileSizeForCompression         int64  `json:"min_file_size_for_comp"`
+	StaticCompressionEnableCpuUsage   int64  `json:"static_compression_enable_cpu_usage"`
+	StaticCompressionDisableCpuUsage  int64  `json:"static_compression_disable_cpu_usage"`
+	DynamicCompressionEnableCpuUsage  int64  `json:"dynamic_compression_enable_cpu_usage"`
+	DynamicCompressionDisableCpuUsage int64  `json:"dynamic_compression_disable_cpu_usage"`
+}
+
+// ResponseCompressionUpdate only sends the settings which are set, as most
+// of them can only be changed at the web server level.
+type ResponseCompressionUpdate struct {
+	DoStaticCompression               *bool   `json:"do_static_compression,omitempty"`
+	DoDynamicCompression              *bool   `json:"do_dynamic_compression,omitempty"`
+	Directory                         *string `json:"directory,omitempty"`
+	DoDiskSpaceLimiting               *bool   `json:"do_disk_space_limitting,omitempty"`
+	MaxDiskSpaceUsage                 *int64  `json:"max_disk_space_usage,omitempty"`
+	MinFileSizeForCompression         *int64  `json:"min_file_size_for_comp,omitempty"`
+	StaticCompressionEnableCpuUsage   *int64  `json:"static_compression_enable_cpu_usage,omitempty"`
+	StaticCompressionDisableCpuUsage  *int64  `json:"static_compression_disable_cpu_usage,omitempty"`
+	DynamicCompressionEnableCpuUsage  *int64  `json:"dynamic_compression_enable_cpu_usage,omitempty"`
+	DynamicCompressionDisableCpuUsage *int64  `json:"dynamic_compression_disable_cpu_usage,omitempty"`
+}
+
+func (client Client) ReadResponseCompression(ctx context.Context, id string) (*ResponseCompression, error) {
+	url := fmt.Sprintf("/api/webserver/http-response-compression/%s", id)
+	var compression ResponseCompression
+	if err := getJson(ctx, client, url, &compression); err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
+
+func (client Client) ReadResponseCompressionFromScope(ctx context.Context, scope FeatureScope) (*ResponseCompression, error) {
+	url, err := client.readFeatureLink(ctx, scope, "response_compression")
+	if err != nil {
+		return nil, err
+	}
+	var compression ResponseCompression
+	if err := getJson(ctx, client, url, &compression); err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
+
+func (client Client) UpdateResponseCompression(ctx context.Context, id string, update ResponseCompressionUpdate) (*ResponseCompression, error) {
+	url := fmt.Sprintf("/api/webserver/http-response-compression/%s", id)
+	res, err := httpPatch(ctx, client, url, update)
+	if err != nil {
+		return nil, err
+	}
+	var compression ResponseCompression
+	err = json.Unmarshal(res, &compression)
+	if err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
@@ -0,0 +1,106 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type ResponseHeaders struct {
+	ID             string             `json:"id"`
+	AllowKeepAlive bool               `json:"allow_keep_alive"`
+	Links          ResourceReferences `json:"_links,omitempty"`
+}
+
+type ResponseHeader struct {
+	ID              string     `json:"id,omitempty"`
+	Name            string     `json:"name"`
+	Value           string     `json:"value"`
+	ResponseHeaders *Reference `json:"response_headers,omitempty"`
+}
+
+func (client Client) ReadResponseHeaders(ctx context.Context, id string) (*ResponseHeaders, error) {
+	url := fmt.Sprintf("/api/webserver/http-response-headers/%s", id)
+	var headers ResponseHeaders
+	if err := getJson(ctx, client, url, &headers); err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+func (client Client) ReadResponseHeadersFromScope(ctx context.Context, scope FeatureScope) (*ResponseHeaders, error) {
+	url, err := client.readFeatureLink(ctx, scope, "response_headers")
+	if err != nil {
+		return nil, err
+	}
+	var headers ResponseHeaders
+	if err := getJson(ctx, client, url, &headers); err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+func (client Client) UpdateResponseHeaders(ctx context.Context, id string, allowKeepAlive bool) (*ResponseHeaders, error) {
+	reqBody := struct {
+		AllowKeepAlive bool `json:"allow_keep_alive"`
+	}{allowKeepAlive}
+	url := fmt.Sprintf("/api/webserver/http-response-headers/%s", id)
+	res, err := httpPatch(ctx, client, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	var headers ResponseHeaders
+	err = json.Unmarshal(res, &headers)
+	if err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+func (client Client) ListCustomHeaders(ctx context.Context, headers *ResponseHeaders) ([]ResponseHeader, error) {
+	var res struct {
+		CustomHeaders []ResponseHeader `json:"custom_headers"`
+	}
+	if err := client.readFeatureCollection(ctx, headers.Links, "custom_headers", &res); err != nil {
+		return nil, err
+	}
+	return res.CustomHeaders, nil
+}
+
+func (client Client) CreateCustomHeader(ctx context.Context, headers *ResponseHeaders, header ResponseHeader) (*ResponseHeader, error) {
+	header.ResponseHeaders = &Reference{ID: headers.ID}
+	var created ResponseHeader
+	if err := postJson(ctx, client, "/api/webserver/http-response-headers/custom-headers", header, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteCustomHeader(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-response-headers/custom-headers/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListRedirectHeaders(ctx context.Context, headers *ResponseHeaders) ([]ResponseHeader, error) {
+	var res struct {
+		RedirectHeaders []ResponseHeader `json:"redirect_headers"`
+	}
+	if err := client.readFeatureCollection(ctx, headers.Links, "redirect_headers", &res); err != nil {
+		return nil, err
+	}
+	return res.RedirectHeaders, nil
+}
+
+func (client Client) CreateRedirectHeader(ctx context.Context, headers *ResponseHeaders, header ResponseHeader) (*ResponseHeader, error) {
+	header.ResponseHeaders = &Reference{ID: headers.ID}
+	var created ResponseHeader
+	if err := postJson(ctx, client, "/api/webserver/http-response-headers/redirect-headers", header, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) DeleteRedirectHeader(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/http-response-headers/redirect-headers/%s", id)
+	return httpDelete(ctx, client, url)
+}
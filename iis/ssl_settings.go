@@ -0,0 +1,49 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type SslSettings struct {
+	ID                 string `json:"id"`
+	RequireSsl         bool   `json:"require_ssl"`
+	Require128Bit      bool   `json:"require_128_bit"`
+	ClientCertificates string `json:"client_certificates"`
+}
+
+func (client Client) ReadSslSettings(ctx context.Context, id string) (*SslSettings, error) {
+	url := fmt.Sprintf("/api/webserver/ssl-settings/%s", id)
+	var settings SslSettings
+	if err := getJson(ctx, client, url, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (client Client) ReadSslSettingsFromScope(ctx context.Context, scope FeatureScope) (*SslSettings, error) {
+	url, err := client.readFeatureLink(ctx, scope, "ssl")
+	if err != nil {
+		return nil, err
+	}
+	var settings SslSettings
+	if err := getJson(ctx, client, url, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (client Client) UpdateSslSettings(ctx context.Context, settings *SslSettings) (*SslSettings, error) {
+	url := fmt.Sprintf("/api/webserver/ssl-settings/%s", settings.ID)
+	res, err := httpPatch(ctx, client, url, settings)
+	if err != nil {
+		return nil, err
+	}
+	var updated SslSettings
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
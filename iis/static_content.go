@@ -0,0 +1,111 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type StaticContent struct {
+	ID                  string             `json:"id"`
+	DefaultDocFooter    string             `json:"default_doc_footer"`
+	EnableDocFooter     bool               `json:"enable_doc_footer"`
+	IsDocFooterFileName bool               `json:"is_doc_footer_file_name"`
+	ClientCache         ClientCache        `json:"client_cache"`
+	Links               ResourceReferences `json:"_links,omitempty"`
+}
+
+type ClientCache struct {
+	ControlMode string `json:"control_mode"`
+	MaxAge      int64  `json:"max_age"`
+	HttpExpires string `json:"http_expires"`
+}
+
+type MimeMap struct {
+	ID            string     `json:"id,omitempty"`
+	FileExtension string     `json:"file_extension"`
+	MimeType      string     `json:"mime_type"`
+	StaticContent *Reference `json:"static_content,omitempty"`
+}
+
+func (client Client) ReadStaticContent(ctx context.Context, id string) (*StaticContent, error) {
+	url := fmt.Sprintf("/api/webserver/static-content/%s", id)
+	var content StaticContent
+	if err := getJson(ctx, client, url, &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+func (client Client) ReadStaticContentFromScope(ctx context.Context, scope FeatureScope) (*StaticContent, error) {
+	url, err := client.readFeatureLink(ctx, scope, "static_content")
+	if err != nil {
+		return nil, err
+	}
+	var content StaticContent
+	if err := getJson(ctx, client, url, &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+func (client Client) UpdateStaticContent(ctx context.Context, content *StaticContent) (*StaticContent, error) {
+	url := fmt.Sprintf("/api/webserver/static-content/%s", content.ID)
+	res, err := httpPatch(ctx, client, url, content)
+	if err != nil {
+		return nil, err
+	}
+	var updated StaticContent
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) ListMimeMaps(ctx context.Context, content *StaticContent) ([]MimeMap, error) {
+	var res struct {
+		MimeMaps []MimeMap `json:"mime_maps"`
+	}
+	if err := client.readFeatureCollection(ctx, content.Links, "mime_maps", &res); err != nil {
+		return nil, err
+	}
+	return res.MimeMaps, nil
+}
+
+func (client Client) ReadMimeMap(ctx context.Context, id string) (*MimeMap, error) {
+	url := fmt.Sprintf("/api/webserver/static-content/mime-maps/%s", id)
+	var mimeMap MimeMap
+	if err := getJson(ctx, client, url, &mimeMap); err != nil {
+		return nil, err
+	}
+	return &mimeMap, nil
+}
+
+func (client Client) CreateMimeMap(ctx context.Context, content *StaticContent, mimeMap MimeMap) (*MimeMap, error) {
+	mimeMap.StaticContent = &Reference{ID: content.ID}
+	var created MimeMap
+	if err := postJson(ctx, client, "/api/webserver/static-content/mime-maps", mimeMap, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateMimeMap(ctx context.Context, mimeMap *MimeMap) (*MimeMap, error) {
+	url := fmt.Sprintf("/api/webserver/static-content/mime-maps/%s", mimeMap.ID)
+	res, err := httpPatch(ctx, client, url, mimeMap)
+	if err != nil {
+		return nil, err
+	}
+	var updated MimeMap
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteMimeMap(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/static-content/mime-maps/%s", id)
+	return httpDelete(ctx, client, url)
+}
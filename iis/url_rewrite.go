@@ -0,0 +1,41 @@
+package iis
+
+import (
+	"context"
+)
+
+type UrlRewrite struct {
+	ID    string             `json:"id"`
+	Links ResourceReferences `json:"_links,omitempty"`
+}
+
+// UrlRewriteSection is one of the sections of the url rewrite feature, like
+// the inbound rules or the rewrite maps, which link to their entries.
+type UrlRewriteSection struct {
+	ID    string             `json:"id"`
+	Links ResourceReferences `json:"_links,omitempty"`
+}
+
+func (client Client) ReadUrlRewriteFromScope(ctx context.Context, scope FeatureScope) (*UrlRewrite, error) {
+	url, err := client.readFeatureLink(ctx, scope, "url_rewrite")
+	if err != nil {
+		return nil, err
+	}
+	var rewrite UrlRewrite
+	if err := getJson(ctx, client, url, &rewrite); err != nil {
+		return nil, err
+	}
+	return &rewrite, nil
+}
+
+func (client Client) readUrlRewriteSection(ctx context.Context, scope FeatureScope, rel string) (*UrlRewriteSection, error) {
+	rewrite, err := client.ReadUrlRewriteFromScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	var section UrlRewriteSection
+	if err := client.readFeatureCollection(ctx, rewrite.Links, rel, &section); err != nil {
+		return nil, err
+	}
+	return &section, nil
+}
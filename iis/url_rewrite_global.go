@@ -0,0 +1,58 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) ReadGlobalRules(ctx context.Context) (*UrlRewriteSection, error) {
+	return client.readUrlRewriteSection(ctx, FeatureScope{}, "global_rules")
+}
+
+func (client Client) ListGlobalRules(ctx context.Context, section *UrlRewriteSection) ([]RewriteRule, error) {
+	var res struct {
+		Rules []RewriteRule `json:"rules"`
+	}
+	if err := client.readFeatureCollection(ctx, section.Links, "rules", &res); err != nil {
+		return nil, err
+	}
+	return res.Rules, nil
+}
+
+func (client Client) ReadGlobalRule(ctx context.Context, id string) (*RewriteRule, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/global-rules/rules/%s", id)
+	var rule RewriteRule
+	if err := getJson(ctx, client, url, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (client Client) CreateGlobalRule(ctx context.Context, section *UrlRewriteSection, rule RewriteRule) (*RewriteRule, error) {
+	rule.GlobalRules = &Reference{ID: section.ID}
+	var created RewriteRule
+	if err := postJson(ctx, client, "/api/webserver/url-rewrite/global-rules/rules", rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateGlobalRule(ctx context.Context, rule *RewriteRule) (*RewriteRule, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/global-rules/rules/%s", rule.ID)
+	res, err := httpPatch(ctx, client, url, rule)
+	if err != nil {
+		return nil, err
+	}
+	var updated RewriteRule
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteGlobalRule(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/global-rules/rules/%s", id)
+	return httpDelete(ctx, client, url)
+}
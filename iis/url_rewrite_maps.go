@@ -0,0 +1,115 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// RewriteMap holds the mappings rules can look up with {MapName:{Input}}.
+// Its mappings are read and written through their own collection, so large
+// maps can be changed entry by entry.
+type RewriteMap struct {
+	ID           string             `json:"id,omitempty"`
+	Name         string             `json:"name"`
+	DefaultValue string             `json:"default_value"`
+	IgnoreCase   bool               `json:"ignore_case"`
+	RewriteMaps  *Reference         `json:"rewrite_maps,omitempty"`
+	Links        ResourceReferences `json:"_links,omitempty"`
+}
+
+type RewriteMapEntry struct {
+	ID            string     `json:"id,omitempty"`
+	OriginalValue string     `json:"original_value"`
+	NewValue      string     `json:"new_value"`
+	RewriteMap    *Reference `json:"rewrite_map,omitempty"`
+}
+
+func (client Client) ReadRewriteMapsFromScope(ctx context.Context, scope FeatureScope) (*UrlRewriteSection, error) {
+	return client.readUrlRewriteSection(ctx, scope, "rewrite_maps")
+}
+
+func (client Client) ListRewriteMaps(ctx context.Context, section *UrlRewriteSection) ([]RewriteMap, error) {
+	var res struct {
+		Entries []RewriteMap `json:"entries"`
+	}
+	if err := client.readFeatureCollection(ctx, section.Links, "entries", &res); err != nil {
+		return nil, err
+	}
+	return res.Entries, nil
+}
+
+func (client Client) ReadRewriteMap(ctx context.Context, id string) (*RewriteMap, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/rewrite-maps/entries/%s", id)
+	var rewriteMap RewriteMap
+	if err := getJson(ctx, client, url, &rewriteMap); err != nil {
+		return nil, err
+	}
+	return &rewriteMap, nil
+}
+
+func (client Client) CreateRewriteMap(ctx context.Context, section *UrlRewriteSection, rewriteMap RewriteMap) (*RewriteMap, error) {
+	rewriteMap.RewriteMaps = &Reference{ID: section.ID}
+	var created RewriteMap
+	if err := postJson(ctx, client, "/api/webserver/url-rewrite/rewrite-maps/entries", rewriteMap, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateRewriteMap(ctx context.Context, rewriteMap *RewriteMap) (*RewriteMap, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/rewrite-maps/entries/%s", rewriteMap.ID)
+	res, err := httpPatch(ctx, client, url, rewriteMap)
+	if err != nil {
+		return nil, err
+	}
+	var updated RewriteMap
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteRewriteMap(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/rewrite-maps/entries/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListRewriteMapEntries(ctx context.Context, rewriteMap *RewriteMap) ([]RewriteMapEntry, error) {
+	var res struct {
+		Mappings []RewriteMapEntry `json:"mappings"`
+	}
+	if err := client.readFeatureCollection(ctx, rewriteMap.Links, "mappings", &res); err != nil {
+		return nil, err
+	}
+	return res.Mappings, nil
+}
+
+func (client Client) CreateRewriteMapEntry(ctx context.Context, rewriteMap *RewriteMap, entry RewriteMapEntry) (*RewriteMapEntry, error) {
+	entry.RewriteMap = &Reference{ID: rewriteMap.ID}
+	var created RewriteMapEntry
+	if err := postJson(ctx, client, "/api/webserver/url-rewrite/rewrite-maps/mappings", entry, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateRewriteMapEntry(ctx context.Context, entry *RewriteMapEntry) (*RewriteMapEntry, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/rewrite-maps/mappings/%s", entry.ID)
+	res, err := httpPatch(ctx, client, url, entry)
+	if err != nil {
+		return nil, err
+	}
+	var updated RewriteMapEntry
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteRewriteMapEntry(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/rewrite-maps/mappings/%s", id)
+	return httpDelete(ctx, client, url)
+}
@@ -0,0 +1,142 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// OutboundRule rewrites the content or the server variables of a response.
+type OutboundRule struct {
+	ID string `json:"id,omitempty"`
+	RewriteMatch
+	Precondition   string          `json:"precondition"`
+	MatchType      string          `json:"match_type"`
+	ServerVariable string          `json:"server_variable"`
+	TagFilters     map[string]bool `json:"tag_filters"`
+	CustomTags     string          `json:"custom_tags"`
+	Action         OutboundAction  `json:"action"`
+	OutboundRules  *Reference      `json:"outbound_rules,omitempty"`
+}
+
+type OutboundAction struct {
+	Type                  string `json:"type"`
+	Value                 string `json:"value"`
+	ReplaceServerVariable bool   `json:"replace_server_variable"`
+}
+
+// RewritePrecondition restricts outbound rules to responses matching its
+// patterns.
+type RewritePrecondition struct {
+	ID            string                `json:"id,omitempty"`
+	Name          string                `json:"name"`
+	Match         string                `json:"match"`
+	PatternSyntax string                `json:"pattern_syntax"`
+	Patterns      []PreconditionPattern `json:"patterns"`
+	OutboundRules *Reference            `json:"outbound_rules,omitempty"`
+}
+
+type PreconditionPattern struct {
+	Input      string `json:"input"`
+	Pattern    string `json:"pattern"`
+	Negate     bool   `json:"negate"`
+	IgnoreCase bool   `json:"ignore_case"`
+}
+
+func (client Client) ReadOutboundRulesFromScope(ctx context.Context, scope FeatureScope) (*UrlRewriteSection, error) {
+	return client.readUrlRewriteSection(ctx, scope, "outbound_rules")
+}
+
+func (client Client) ListOutboundRules(ctx context.Context, section *UrlRewriteSection) ([]OutboundRule, error) {
+	var res struct {
+		Rules []OutboundRule `json:"rules"`
+	}
+	if err := client.readFeatureCollection(ctx, section.Links, "rules", &res); err != nil {
+		return nil, err
+	}
+	return res.Rules, nil
+}
+
+func (client Client) ReadOutboundRule(ctx context.Context, id string) (*OutboundRule, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/rules/%s", id)
+	var rule OutboundRule
+	if err := getJson(ctx, client, url, &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (client Client) CreateOutboundRule(ctx context.Context, section *UrlRewriteSection, rule OutboundRule) (*OutboundRule, error) {
+	rule.OutboundRules = &Reference{ID: section.ID}
+	var created OutboundRule
+	if err := postJson(ctx, client, "/api/webserver/url-rewrite/outbound-rules/rules", rule, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdateOutboundRule(ctx context.Context, rule *OutboundRule) (*OutboundRule, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/rules/%s", rule.ID)
+	res, err := httpPatch(ctx, client, url, rule)
+	if err != nil {
+		return nil, err
+	}
+	var updated OutboundRule
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeleteOutboundRule(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/rules/%s", id)
+	return httpDelete(ctx, client, url)
+}
+
+func (client Client) ListPreconditions(ctx context.Context, section *UrlRewriteSection) ([]RewritePrecondition, error) {
+	var res struct {
+		Preconditions []RewritePrecondition `json:"preconditions"`
+	}
+	if err := client.readFeatureCollection(ctx, section.Links, "preconditions", &res); err != nil {
+		return nil, err
+	}
+	return res.Preconditions, nil
+}
+
+func (client Client) ReadPrecondition(ctx context.Context, id string) (*RewritePrecondition, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/preconditions/%s", id)
+	var precondition RewritePrecondition
+	if err := getJson(ctx, client, url, &precondition); err != nil {
+		return nil, err
+	}
+	return &precondition, nil
+}
+
+func (client Client) CreatePrecondition(ctx context.Context, section *UrlRewriteSection, precondition RewritePrecondition) (*RewritePrecondition, error) {
+	precondition.OutboundRules = &Reference{ID: section.ID}
+	var created RewritePrecondition
+	if err := postJson(ctx, client, "/api/webserver/url-rewrite/outbound-rules/preconditions", precondition, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client Client) UpdatePrecondition(ctx context.Context, precondition *RewritePrecondition) (*RewritePrecondition, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/preconditions/%s", precondition.ID)
+	res, err := httpPatch(ctx, client, url, precondition)
+	if err != nil {
+		return nil, err
+	}
+	var updated RewritePrecondition
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (client Client) DeletePrecondition(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/outbound-rules/preconditions/%s", id)
+	return httpDelete(ctx, client, url)
+}
@@ -0,0 +1,50 @@
+package iis
+
+// RewriteMatch holds the matching settings common to all url rewrite rules.
+type RewriteMatch struct {
+	Name                      string             `json:"name"`
+	Pattern                   string             `json:"pattern"`
+	PatternSyntax             string             `json:"pattern_syntax"`
+	IgnoreCase                bool               `json:"ignore_case"`
+	Negate                    bool               `json:"negate"`
+	StopProcessing            bool               `json:"stop_processing"`
+	ConditionMatchConstraints string             `json:"condition_match_constraints"`
+	TrackAllCaptures          bool               `json:"track_all_captures"`
+	Conditions                []RewriteCondition `json:"conditions"`
+	Priority                  *int               `json:"priority,omitempty"`
+}
+
+// RewriteRule is shared by the inbound and the global rules of url rewrite.
+type RewriteRule struct {
+	ID string `json:"id,omitempty"`
+	RewriteMatch
+	ServerVariables []RewriteServerVariable `json:"server_variables"`
+	Action          RewriteAction           `json:"action"`
+	InboundRules    *Reference              `json:"inbound_rules,omitempty"`
+	GlobalRules     *Reference              `json:"global_rules,omitempty"`
+}
+
+type RewriteCondition struct {
+	Input      string `json:"input"`
+	Pattern    string `json:"pattern"`
+	Negate     bool   `json:"negate"`
+	IgnoreCase bool   `json:"ignore_case"`
+	MatchType  string `json:"match_type"`
+}
+
+type RewriteServerVariable struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Replace bool   `json:"replace"`
+}
+
+type RewriteAction struct {
+	Type              string `json:"type"`
+	Url               string `json:"url"`
+	AppendQueryString bool   `json:"append_query_string"`
+	RedirectType      string `json:"redirect_type"`
+	StatusCode        int    `json:"status_code"`
+	SubStatusCode     int    `json:"sub_status_code"`
+	Reason            string `json:"reason"`
+	Description       string `json:"description"`
+}
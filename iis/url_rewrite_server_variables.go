@@ -0,0 +1,47 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AllowedServerVariables lists the server variables rules may set.
+type AllowedServerVariables struct {
+	ID      string   `json:"id"`
+	Entries []string `json:"entries"`
+}
+
+func (client Client) ReadAllowedServerVariables(ctx context.Context, id string) (*AllowedServerVariables, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/allowed-server-variables/%s", id)
+	var variables AllowedServerVariables
+	if err := getJson(ctx, client, url, &variables); err != nil {
+		return nil, err
+	}
+	return &variables, nil
+}
+
+func (client Client) ReadAllowedServerVariablesFromScope(ctx context.Context, scope FeatureScope) (*AllowedServerVariables, error) {
+	section, err := client.readUrlRewriteSection(ctx, scope, "allowed_server_variables")
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadAllowedServerVariables(ctx, section.ID)
+}
+
+func (client Client) UpdateAllowedServerVariables(ctx context.Context, id string, entries []string) (*AllowedServerVariables, error) {
+	url := fmt.Sprintf("/api/webserver/url-rewrite/allowed-server-variables/%s", id)
+	request := struct {
+		Entries []string `json:"entries"`
+	}{entries}
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var updated AllowedServerVariables
+	err = json.Unmarshal(res, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -18,6 +18,14 @@ func getJson(ctx context.Context, client Client, path string, r interface{}) err
 	return json.Unmarshal(data, &r)
 }
 
+func postJson(ctx context.Context, client Client, path string, body interface{}, r interface{}) error {
+	data, err := httpPost(ctx, client, path, body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &r)
+}
+
 func httpGet(ctx context.Context, client Client, path string) ([]byte, error) {
 	response, err := request(ctx, client, "GET", path, nil)
 	if err != nil {
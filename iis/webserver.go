@@ -0,0 +1,16 @@
+package iis
+
+import "context"
+
+type WebServer struct {
+	ID    string             `json:"id"`
+	Links ResourceReferences `json:"_links,omitempty"`
+}
+
+func (client Client) ReadWebServer(ctx context.Context) (*WebServer, error) {
+	var server WebServer
+	if err := getJson(ctx, client, "/api/webserver", &server); err != nil {
+		return nil, err
+	}
+	return &server, nil
+}
@@ -6,11 +6,14 @@ type Website struct {
 	PhysicalPath    string               `json:"physical_path"`
 	Bindings        []WebsiteBinding     `json:"bindings"`
 	ApplicationPool ApplicationReference `json:"application_pool"`
+	Links           ResourceReferences   `json:"_links,omitempty"`
 }
 
 type WebsiteBinding struct {
-	Protocol  string `json:"protocol"`
-	Port      int    `json:"port"`
-	IPAddress string `json:"ip_address"`
-	Hostname  string `json:"hostname"`
+	Protocol                   string                `json:"protocol"`
+	Port                       int                   `json:"port"`
+	IPAddress                  string                `json:"ip_address"`
+	Hostname                   string                `json:"hostname"`
+	Certificate                *CertificateReference `json:"certificate,omitempty"`
+	UseCentralCertificateStore bool                  `json:"use_central_certificate_store,omitempty"`
 }
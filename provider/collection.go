@@ -0,0 +1,52 @@
+package provider
+
+// syncCollection brings a configuration collection in line with the desired
+// entries. Entries are matched by their key, so entries present on both sides
+// are left untouched, others are removed or added.
+func syncCollection[T any](existing, desired []T, key func(T) string, remove func(T) error, add func(T) error) error {
+	wanted := make(map[string]bool, len(desired))
+	for _, entry := range desired {
+		wanted[key(entry)] = true
+	}
+	present := make(map[string]bool, len(existing))
+	for _, entry := range existing {
+		k := key(entry)
+		if wanted[k] && !present[k] {
+			present[k] = true
+			continue
+		}
+		if err := remove(entry); err != nil {
+			return err
+		}
+	}
+	for _, entry := range desired {
+		k := key(entry)
+		if present[k] {
+			continue
+		}
+		if err := add(entry); err != nil {
+			return err
+		}
+		present[k] = true
+	}
+	return nil
+}
+
+// managedEntries filters entries down to the ones whose key appears in any of
+// the managed lists. It keeps resources from touching entries which are
+// inherited or configured outside of Terraform.
+func managedEntries[T any](entries []T, key func(T) string, managed ...[]T) []T {
+	keys := make(map[string]bool)
+	for _, list := range managed {
+		for _, entry := range list {
+			keys[key(entry)] = true
+		}
+	}
+	var filtered []T
+	for _, entry := range entries {
+		if keys[key(entry)] {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
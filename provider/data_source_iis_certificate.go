@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIisCertificate() *schema.Resource {
+	s := certificateSchema()
+	delete(s, certificateIdKey)
+	return &schema.Resource{
+		ReadContext: dataSourceIisCertificateRead,
+		Schema:      certificateFilterSchema(s),
+	}
+}
+
+func dataSourceIisCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	certificates, err := findCertificates(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(certificates) != 1 {
+		return diag.FromErr(fmt.Errorf("expected exactly one certificate to match, found %d", len(certificates)))
+	}
+	certificate := certificates[0]
+	d.SetId(certificate.ID)
+	for key, value := range flattenCertificate(certificate) {
+		if key == certificateIdKey {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
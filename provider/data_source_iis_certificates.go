@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const certificatesKey = "certificates"
+const certificateIdKey = "id"
+const certificateThumbprintKey = "thumbprint"
+const certificateSubjectKey = "subject"
+const certificateIssuerKey = "issuer"
+const certificateStoreNameKey = "store_name"
+const certificateValidityStartKey = "validity_start"
+const certificateValidityEndKey = "validity_end"
+const certificateAliasKey = "alias"
+const certificateSubjectAlternativeNamesKey = "subject_alternative_names"
+const certificateNotBeforeKey = "not_before"
+const certificateExpirationDateKey = "expiration_date"
+const certificateIntendedUsagesKey = "intended_usages"
+
+func dataSourceIisCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIisCertificatesRead,
+		Schema: certificateFilterSchema(map[string]*schema.Schema{
+			certificatesKey: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: certificateSchema(),
+				},
+			},
+		}),
+	}
+}
+
+// certificateSchema describes a certificate as returned by the data sources.
+func certificateSchema() map[string]*schema.Schema {
+	computed := func(t schema.ValueType) *schema.Schema {
+		s := &schema.Schema{Type: t, Computed: true}
+		if t == schema.TypeList {
+			s.Elem = &schema.Schema{Type: schema.TypeString}
+		}
+		return s
+	}
+	return map[string]*schema.Schema{
+		certificateIdKey:                      computed(schema.TypeString),
+		certificateThumbprintKey:              computed(schema.TypeString),
+		certificateAliasKey:                   computed(schema.TypeString),
+		certificateSubjectKey:                 computed(schema.TypeString),
+		certificateIssuerKey:                  computed(schema.TypeString),
+		certificateStoreNameKey:               computed(schema.TypeString),
+		certificateSubjectAlternativeNamesKey: computed(schema.TypeList),
+		certificateNotBeforeKey:               computed(schema.TypeString),
+		certificateExpirationDateKey:          computed(schema.TypeString),
+		certificateIntendedUsagesKey:          computed(schema.TypeList),
+	}
+}
+
+// certificateFilterSchema adds the attributes certificates can be filtered
+// by. Filters which are also part of the certificate stay computed, so
+// iis_certificate can report them when they are not set.
+func certificateFilterSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	filters := map[string]string{
+		certificateThumbprintKey: "Thumbprint of the certificate, case and spaces are ignored",
+		certificateSubjectKey:    "Part of the subject of the certificate, e.g. CN=example.com",
+		certificateIssuerKey:     "Part of the name of the issuer of the certificate",
+		certificateStoreNameKey:  "Name of the store holding the certificate, e.g. My or WebHosting",
+	}
+	for key, description := range filters {
+		_, computed := s[key]
+		s[key] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    computed,
+			Description: description,
+		}
+	}
+	s[certificateValidityStartKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "RFC 3339 timestamp the certificate has to be valid from",
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+	s[certificateValidityEndKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "RFC 3339 timestamp the certificate has to be valid until",
+		ValidateFunc: validation.IsRFC3339Time,
+	}
+	return s
+}
+
+func dataSourceIisCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	certificates, err := findCertificates(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	list := make([]interface{}, len(certificates))
+	for i, certificate := range certificates {
+		list[i] = flattenCertificate(certificate)
+	}
+	d.SetId(id.UniqueId())
+	if err := d.Set(certificatesKey, list); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// findCertificates lists the certificates matching the filters of d.
+func findCertificates(ctx context.Context, client *iis.Client, d *schema.ResourceData) ([]iis.Certificate, error) {
+	certificates, err := client.ListCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var validityStart, validityEnd time.Time
+	if v, ok := d.GetOk(certificateValidityStartKey); ok {
+		validityStart, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk(certificateValidityEndKey); ok {
+		validityEnd, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	thumbprint := normalizeThumbprint(d.Get(certificateThumbprintKey).(string))
+	subject := strings.ToLower(d.Get(certificateSubjectKey).(string))
+	issuer := strings.ToLower(d.Get(certificateIssuerKey).(string))
+	storeName := d.Get(certificateStoreNameKey).(string)
+
+	var matches []iis.Certificate
+	for _, certificate := range certificates {
+		if thumbprint != "" && normalizeThumbprint(certificate.Thumbprint) != thumbprint {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(certificate.Subject), subject) {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(certificate.IssuedBy), issuer) {
+			continue
+		}
+		if storeName != "" && !strings.EqualFold(certificate.Store.Name, storeName) {
+			continue
+		}
+		if !validityStart.IsZero() && (certificate.ValidFrom.After(validityStart) || certificate.ValidTo.Before(validityStart)) {
+			continue
+		}
+		if !validityEnd.IsZero() && (certificate.ValidFrom.After(validityEnd) || certificate.ValidTo.Before(validityEnd)) {
+			continue
+		}
+		matches = append(matches, certificate)
+	}
+	return matches, nil
+}
+
+func normalizeThumbprint(thumbprint string) string {
+	return strings.ToUpper(strings.ReplaceAll(thumbprint, " ", ""))
+}
+
+func flattenCertificate(certificate iis.Certificate) map[string]interface{} {
+	return map[string]interface{}{
+		certificateIdKey:                      certificate.ID,
+		certificateThumbprintKey:              certificate.Thumbprint,
+		certificateAliasKey:                   certificate.Alias,
+		certificateSubjectKey:                 certificate.Subject,
+		certificateIssuerKey:                  certificate.IssuedBy,
+		certificateStoreNameKey:               certificate.Store.Name,
+		certificateSubjectAlternativeNamesKey: certificate.SubjectAlternativeNames,
+		certificateNotBeforeKey:               certificate.ValidFrom.Format(time.RFC3339),
+		certificateExpirationDateKey:          certificate.ValidTo.Format(time.RFC3339),
+		certificateIntendedUsagesKey:          certificate.IntendedPurposes,
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
 func dataSourceIisWebsite() *schema.Resource {
@@ -23,7 +22,7 @@ func dataSourceIisWebsite() *schema.Resource {
 }
 
 func dataSourceIisWebsiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 
 	sites, err := client.ListWebsites(ctx)
 	if err != nil {
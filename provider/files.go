@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// windowsPath normalizes a path on the IIS server to backslashes without a
+// trailing separator, except for drive roots.
+func windowsPath(path string) string {
+	path = strings.ReplaceAll(path, "/", `\`)
+	if len(path) > 3 {
+		path = strings.TrimRight(path, `\`)
+	}
+	return path
+}
+
+func splitWindowsPath(path string) (string, string) {
+	i := strings.LastIndex(path, `\`)
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
+
+// ensureDirectory returns the directory at path, creating it along with any
+// missing parents below the locations the IIS Administration API may access.
+func ensureDirectory(ctx context.Context, client *iis.Client, path string) (*iis.File, error) {
+	path = windowsPath(path)
+	directory, err := client.FindFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if directory != nil {
+		if directory.Type != iis.FileTypeDirectory {
+			return nil, fmt.Errorf("%s is not a directory", path)
+		}
+		return directory, nil
+	}
+	parentPath, name := splitWindowsPath(path)
+	if parentPath == "" || name == "" {
+		return nil, fmt.Errorf("%s does not exist or is outside of the locations the IIS Administration API may access", path)
+	}
+	parent, err := ensureDirectory(ctx, client, parentPath)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "Creating directory: "+toJSON(path))
+	return client.CreateFile(ctx, parent, name, iis.FileTypeDirectory)
+}
+
+func hashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// runParallel runs the jobs with at most concurrency of them at a time and
+// returns the first error. No further jobs are started once a job failed.
+func runParallel(concurrency int, jobs []func() error) error {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	failed := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return firstErr != nil
+	}
+	slots := make(chan struct{}, concurrency)
+	for _, job := range jobs {
+		slots <- struct{}{}
+		if failed() {
+			break
+		}
+		wg.Add(1)
+		go func(job func() error) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := job(); err != nil {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mutex.Unlock()
+			}
+		}(job)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const validatePhysicalPathKey = "validate_physical_path"
+const pathVariablesKey = "path_variables"
+
+// defaultPathVariables assumes Windows to be installed on drive C:, as the
+// physical paths of IIS, e.g. %SystemDrive%\inetpub\wwwroot, refer to it.
+// Servers installed elsewhere override it through path_variables.
+var defaultPathVariables = map[string]string{
+	"SystemDrive": `C:`,
+}
+
+var pathVariablePattern = regexp.MustCompile(`%([^%]+)%`)
+
+// expandPathVariables replaces %NAME% references in path. Names are matched
+// case-insensitively like on Windows and unknown variables are kept.
+func expandPathVariables(path string, variables map[string]string) string {
+	return pathVariablePattern.ReplaceAllStringFunc(path, func(match string) string {
+		name := match[1 : len(match)-1]
+		for variable, value := range variables {
+			if strings.EqualFold(variable, name) {
+				return value
+			}
+		}
+		return match
+	})
+}
+
+// checkPhysicalPath reports a missing physical path before IIS accepts it
+// and fails the first request. The check runs if enabled for the resource
+// or, when the resource does not set it, for the provider.
+func checkPhysicalPath(ctx context.Context, d *schema.ResourceData, meta *providerMeta) diag.Diagnostics {
+	enabled := meta.validatePhysicalPath
+	if isConfigured(d, validatePhysicalPathKey) {
+		enabled = d.Get(validatePhysicalPathKey).(bool)
+	}
+	if !enabled {
+		return nil
+	}
+	physicalPath := d.Get(physicalPathKey).(string)
+	expanded := windowsPath(expandPathVariables(physicalPath, meta.pathVariables))
+	if unknown := pathVariablePattern.FindString(expanded); unknown != "" {
+		// The environment of the server is not known to the provider, so
+		// guessing e.g. SystemDrive could reject valid paths.
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Physical path %s not checked", physicalPath),
+			Detail:        fmt.Sprintf("The variable %s is not set in the provider's %s, so the physical path cannot be checked.", unknown, pathVariablesKey),
+			AttributePath: cty.GetAttrPath(physicalPathKey),
+		}}
+	}
+	file, err := meta.client.FindFile(ctx, expanded)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("checking physical path %s: %w", expanded, err))
+	}
+	detail := ""
+	switch {
+	case file == nil:
+		detail = fmt.Sprintf("The directory %s does not exist on the server or is outside of the locations the IIS Administration API may access. Create it first, e.g. with iis_directory.", expanded)
+	case file.Type != iis.FileTypeDirectory:
+		detail = fmt.Sprintf("%s is not a directory.", expanded)
+	default:
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("Physical path %s not found", physicalPath),
+		Detail:        detail,
+		AttributePath: cty.GetAttrPath(physicalPathKey),
+	}}
+}
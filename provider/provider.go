@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
@@ -11,6 +13,19 @@ import (
 	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
+const certificateExpiryWarningDaysKey = "certificate_expiry_warning_days"
+const failOnCertificateExpiryKey = "fail_on_certificate_expiry"
+
+// providerMeta is passed to all resources and holds the client along with
+// the provider wide settings.
+type providerMeta struct {
+	client                  *iis.Client
+	certificateExpiryWindow time.Duration
+	failOnCertificateExpiry bool
+	validatePhysicalPath    bool
+	pathVariables           map[string]string
+}
+
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
@@ -22,15 +37,69 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			certificateExpiryWarningDaysKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Website bindings using certificates expiring within this many days are reported. The plugin SDK cannot warn while planning, so the warnings of new or changed bindings are shown on apply, those of existing bindings on refresh",
+			},
+			failOnCertificateExpiryKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail plans instead of warning when a website binding uses an expiring certificate",
+			},
+			validatePhysicalPathKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check that the physical path of websites and applications exists before creating them",
+			},
+			pathVariablesKey: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Environment variables of the server used to expand physical paths. SystemDrive defaults to \"C:\" and has to be set for servers installed on another drive. Paths referring to other variables are not checked",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"iis_application_pool": resourceApplicationPool(),
-			"iis_application":      resourceApplication(),
-			"iis_authentication":   resourceAuthentication(),
-			"iis_website":          resourceWebsite(),
+			"iis_application_pool":                     resourceApplicationPool(),
+			"iis_application":                          resourceApplication(),
+			"iis_authentication":                       resourceAuthentication(),
+			"iis_authorization":                        resourceAuthorization(),
+			"iis_client_certificate_mapping":           resourceClientCertificateMapping(),
+			"iis_website":                              resourceWebsite(),
+			"iis_ssl_settings":                         resourceSslSettings(),
+			"iis_default_documents":                    resourceDefaultDocuments(),
+			"iis_directory_browsing":                   resourceDirectoryBrowsing(),
+			"iis_ip_restrictions":                      resourceIpRestrictions(),
+			"iis_request_filtering":                    resourceRequestFiltering(),
+			"iis_response_headers":                     resourceResponseHeaders(),
+			"iis_http_redirect":                        resourceHttpRedirect(),
+			"iis_static_content":                       resourceStaticContent(),
+			"iis_mime_map":                             resourceMimeMap(),
+			"iis_response_compression":                 resourceResponseCompression(),
+			"iis_handlers":                             resourceHandlers(),
+			"iis_handler_mapping":                      resourceHandlerMapping(),
+			"iis_global_module":                        resourceGlobalModule(),
+			"iis_module":                               resourceModule(),
+			"iis_logging":                              resourceLogging(),
+			"iis_request_tracing":                      resourceRequestTracing(),
+			"iis_url_rewrite_inbound_rule":             resourceUrlRewriteInboundRule(),
+			"iis_url_rewrite_outbound_rule":            resourceUrlRewriteOutboundRule(),
+			"iis_url_rewrite_precondition":             resourceUrlRewritePrecondition(),
+			"iis_url_rewrite_map":                      resourceUrlRewriteMap(),
+			"iis_url_rewrite_allowed_server_variables": resourceUrlRewriteAllowedServerVariables(),
+			"iis_url_rewrite_global_rule":              resourceUrlRewriteGlobalRule(),
+			"iis_central_certificate_store":            resourceCentralCertificateStore(),
+			"iis_directory":                            resourceDirectory(),
+			"iis_file":                                 resourceFile(),
+			"iis_directory_sync":                       resourceDirectorySync(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"iis_website": dataSourceIisWebsite(),
+			"iis_website":      dataSourceIisWebsite(),
+			"iis_certificate":  dataSourceIisCertificate(),
+			"iis_certificates": dataSourceIisCertificates(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -49,5 +118,25 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		AccessKey: d.Get("access_key").(string),
 	}
 
-	return client, nil
+	pathVariables := make(map[string]string)
+	for name, value := range defaultPathVariables {
+		pathVariables[name] = value
+	}
+	for name, value := range d.Get(pathVariablesKey).(map[string]interface{}) {
+		for defaultName := range defaultPathVariables {
+			if strings.EqualFold(defaultName, name) {
+				delete(pathVariables, defaultName)
+			}
+		}
+		pathVariables[name] = value.(string)
+	}
+
+	days := d.Get(certificateExpiryWarningDaysKey).(int)
+	return &providerMeta{
+		client:                  client,
+		certificateExpiryWindow: time.Duration(days) * 24 * time.Hour,
+		failOnCertificateExpiry: d.Get(failOnCertificateExpiryKey).(bool),
+		validatePhysicalPath:    d.Get(validatePhysicalPathKey).(bool),
+		pathVariables:           pathVariables,
+	}, nil
 }
@@ -38,6 +38,11 @@ func resourceApplication() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			validatePhysicalPathKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check that the physical path exists before creating, overriding the provider setting",
+			},
 			"location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +52,11 @@ func resourceApplication() *schema.Resource {
 }
 
 func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
+	diags := checkPhysicalPath(ctx, d, m.(*providerMeta))
+	if diags.HasError() {
+		return diags
+	}
 	request := createApplicationRequest(d)
 	tflog.Debug(ctx, "Creating application: "+toJSON(request))
 	application, err := client.CreateApplication(ctx, request)
@@ -56,11 +65,11 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 	tflog.Debug(ctx, "Created application: "+toJSON(application))
 	d.SetId(application.ID)
-	return nil
+	return diags
 }
 
 func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	application, err := client.ReadApplication(ctx, d.Id())
 	if err != nil {
 		d.SetId("")
@@ -84,7 +93,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	id := d.Id()
 	tflog.Debug(ctx, "Deleting application: "+toJSON(id))
 	err := client.DeleteApplication(ctx, id)
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
 const NameKey = "name"
@@ -34,7 +33,7 @@ func resourceApplicationPool() *schema.Resource {
 }
 
 func resourceApplicationPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	name := d.Get(NameKey).(string)
 	tflog.Debug(ctx, "Creating application pool: "+toJSON(name))
 	pool, err := client.CreateAppPool(ctx, name)
@@ -47,7 +46,7 @@ func resourceApplicationPoolCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceApplicationPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	id := d.Id()
 	appPool, err := client.ReadAppPool(ctx, id)
 	if err != nil {
@@ -66,7 +65,7 @@ func resourceApplicationPoolRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceApplicationPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	if d.HasChange(NameKey) {
 		name := d.Get(NameKey).(string)
 		tflog.Debug(ctx, "Updating application pool: "+toJSON(name))
@@ -81,7 +80,7 @@ func resourceApplicationPoolUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceApplicationPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	id := d.Id()
 	tflog.Debug(ctx, "Deleting application pool: "+toJSON(id))
 	err := client.DeleteAppPool(ctx, id)
@@ -87,7 +87,7 @@ func resourceAuthentication() *schema.Resource {
 }
 
 func resourceAuthenticationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	application := d.Get("application").(string)
 	tflog.Debug(ctx, "Creating authentication: "+toJSON(application))
 	auth, err := client.ReadAuthenticationFromApplication(ctx, application)
@@ -103,7 +103,7 @@ func resourceAuthenticationCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAuthenticationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	auth, err := client.ReadAuthentication(ctx, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -123,7 +123,7 @@ func resourceAuthenticationRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceAuthenticationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	tflog.Debug(ctx, "Updating authentication: "+toJSON(d.Id()))
 	auth, err := client.ReadAuthentication(ctx, d.Id())
 	if err != nil {
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const bypassInheritedRulesKey = "bypass_inherited_rules"
+const authorizationRuleKey = "rule"
+const initialBypassInheritedRulesKey = "initial_bypass_inherited_rules"
+
+const ruleAccessTypeKey = "access_type"
+const ruleUsersKey = "users"
+const ruleRolesKey = "roles"
+const ruleVerbsKey = "verbs"
+
+func resourceAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthorizationCreate,
+		ReadContext:   resourceAuthorizationRead,
+		UpdateContext: resourceAuthorizationUpdate,
+		DeleteContext: resourceAuthorizationDelete,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			bypassInheritedRulesKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			authorizationRuleKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        authorizationRuleSchema,
+				Description: "Rules managed by this resource, which are added after inherited and unmanaged rules of the scope",
+			},
+			initialBypassInheritedRulesKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Value of bypass_inherited_rules before the resource was created, restored on destroy",
+			},
+		}),
+	}
+}
+
+var authorizationRuleSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		ruleAccessTypeKey: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+		},
+		ruleUsersKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		ruleRolesKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		ruleVerbsKey: {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+	},
+}
+
+func resourceAuthorizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating authorization: "+toJSON(scope))
+	authorization, err := client.ReadAuthorizationFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(initialBypassInheritedRulesKey, authorization.Bypass); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateAuthorization(ctx, d, client, authorization); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created authorization: "+toJSON(authorization))
+	d.SetId(authorization.ID)
+	return resourceAuthorizationRead(ctx, d, m)
+}
+
+func resourceAuthorizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	authorization, err := client.ReadAuthorization(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read authorization: "+toJSON(authorization))
+	rules, err := client.ListAuthorizationRules(ctx, authorization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(bypassInheritedRulesKey, authorization.Bypass); err != nil {
+		return diag.FromErr(err)
+	}
+	rules = managedEntries(rules, authorizationRuleIdentity, getAuthorizationRules(d.Get(authorizationRuleKey)))
+	if err = d.Set(authorizationRuleKey, mapAuthorizationRulesToList(rules)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating authorization: "+toJSON(d.Id()))
+	authorization, err := client.ReadAuthorization(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateAuthorization(ctx, d, client, authorization); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated authorization: "+toJSON(d.Id()))
+	return resourceAuthorizationRead(ctx, d, m)
+}
+
+func resourceAuthorizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting authorization: "+toJSON(d.Id()))
+	authorization, err := client.ReadAuthorization(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existing, err := client.ListAuthorizationRules(ctx, authorization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, rule := range managedEntries(existing, authorizationRuleIdentity, getAuthorizationRules(d.Get(authorizationRuleKey))) {
+		if err := client.DeleteAuthorizationRule(ctx, rule.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if initial := d.Get(initialBypassInheritedRulesKey).(bool); initial != authorization.Bypass {
+		if _, err := client.UpdateAuthorization(ctx, authorization.ID, initial); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	tflog.Debug(ctx, "Deleted authorization: "+toJSON(d.Id()))
+	return nil
+}
+
+func updateAuthorization(ctx context.Context, d *schema.ResourceData, client *iis.Client, authorization *iis.Authorization) error {
+	bypass := d.Get(bypassInheritedRulesKey).(bool)
+	if bypass != authorization.Bypass {
+		if _, err := client.UpdateAuthorization(ctx, authorization.ID, bypass); err != nil {
+			return err
+		}
+	}
+	if d.IsNewResource() || d.HasChange(authorizationRuleKey) {
+		previous, desired := d.GetChange(authorizationRuleKey)
+		return replaceAuthorizationRules(ctx, client, authorization, getAuthorizationRules(previous), getAuthorizationRules(desired))
+	}
+	return nil
+}
+
+// replaceAuthorizationRules removes the previously managed rules of the scope
+// and adds the given rules in order, as IIS evaluates authorization rules by
+// their position. Inherited and unmanaged rules are left in place, so the
+// managed rules always follow them.
+func replaceAuthorizationRules(ctx context.Context, client *iis.Client, authorization *iis.Authorization, previous, rules []iis.AuthorizationRule) error {
+	existing, err := client.ListAuthorizationRules(ctx, authorization)
+	if err != nil {
+		return err
+	}
+	for _, rule := range managedEntries(existing, authorizationRuleIdentity, previous, rules) {
+		if err := client.DeleteAuthorizationRule(ctx, rule.ID); err != nil {
+			return err
+		}
+	}
+	for _, rule := range rules {
+		if _, err := client.CreateAuthorizationRule(ctx, authorization, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func authorizationRuleIdentity(rule iis.AuthorizationRule) string {
+	return fmt.Sprintf("%s|%s|%s|%s", rule.AccessType, strings.ToLower(rule.Users), strings.ToLower(rule.Roles), strings.Join(rule.Verbs, ","))
+}
+
+func getAuthorizationRules(v interface{}) []iis.AuthorizationRule {
+	entries := v.([]interface{})
+	rules := make([]iis.AuthorizationRule, len(entries))
+	for i, entry := range entries {
+		rule := entry.(map[string]interface{})
+		rules[i] = iis.AuthorizationRule{
+			AccessType: rule[ruleAccessTypeKey].(string),
+			Users:      rule[ruleUsersKey].(string),
+			Roles:      rule[ruleRolesKey].(string),
+			Verbs:      toStringList(rule[ruleVerbsKey].([]interface{})),
+		}
+	}
+	return rules
+}
+
+func mapAuthorizationRulesToList(rules []iis.AuthorizationRule) []interface{} {
+	list := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		list[i] = map[string]interface{}{
+			ruleAccessTypeKey: rule.AccessType,
+			ruleUsersKey:      rule.Users,
+			ruleRolesKey:      rule.Roles,
+			ruleVerbsKey:      rule.Verbs,
+		}
+	}
+	return list
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const centralCertificateStoreUsernameKey = "username"
+const centralCertificateStorePasswordKey = "password_wo"
+const centralCertificateStorePasswordVersionKey = "password_wo_version"
+const centralCertificateStorePrivateKeyPasswordKey = "private_key_password_wo"
+const centralCertificateStorePrivateKeyPasswordVersionKey = "private_key_password_wo_version"
+
+func resourceCentralCertificateStore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCentralCertificateStoreCreate,
+		ReadContext:   resourceCentralCertificateStoreRead,
+		UpdateContext: resourceCentralCertificateStoreUpdate,
+		DeleteContext: resourceCentralCertificateStoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			physicalPathKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the share holding the certificates, e.g. \\\\fileserver\\certificates",
+			},
+			centralCertificateStoreUsernameKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			centralCertificateStorePasswordKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Password of the user accessing the share, which is never stored in the state",
+			},
+			centralCertificateStorePasswordVersionKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Changing the version sends password_wo to IIS again",
+			},
+			centralCertificateStorePrivateKeyPasswordKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Password protecting the private keys of the certificates, which is never stored in the state",
+			},
+			centralCertificateStorePrivateKeyPasswordVersionKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Changing the version sends private_key_password_wo to IIS again",
+			},
+		},
+	}
+}
+
+func resourceCentralCertificateStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	store, err := client.ReadCentralCertificateStore(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getCentralCertificateStore(d, store.ID, true)
+	tflog.Debug(ctx, "Creating central certificate store: "+toJSON(request.Path))
+	store, err = client.UpdateCentralCertificateStore(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created central certificate store: "+toJSON(store))
+	d.SetId(store.ID)
+	return resourceCentralCertificateStoreRead(ctx, d, m)
+}
+
+func resourceCentralCertificateStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	store, err := client.ReadCentralCertificateStore(ctx)
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read central certificate store: "+toJSON(store))
+	if err = d.Set("enabled", store.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(physicalPathKey, store.Path); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(centralCertificateStoreUsernameKey, store.Identity.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceCentralCertificateStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getCentralCertificateStore(d, d.Id(), false)
+	tflog.Debug(ctx, "Updating central certificate store: "+toJSON(request.Path))
+	store, err := client.UpdateCentralCertificateStore(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated central certificate store: "+toJSON(store))
+	return resourceCentralCertificateStoreRead(ctx, d, m)
+}
+
+func resourceCentralCertificateStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting central certificate store: "+toJSON(d.Id()))
+	store, err := client.ReadCentralCertificateStore(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	store.Enabled = false
+	if _, err := client.UpdateCentralCertificateStore(ctx, store); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted central certificate store: "+toJSON(d.Id()))
+	return nil
+}
+
+// getCentralCertificateStore builds the request for the store. The passwords
+// are write-only, so they are only sent on creation or when their version
+// changes.
+func getCentralCertificateStore(d *schema.ResourceData, id string, create bool) *iis.CentralCertificateStore {
+	store := &iis.CentralCertificateStore{
+		ID:      id,
+		Enabled: d.Get("enabled").(bool),
+		Path:    d.Get(physicalPathKey).(string),
+		Identity: iis.CentralCertificateStoreIdentity{
+			Username: d.Get(centralCertificateStoreUsernameKey).(string),
+		},
+	}
+	if create || d.HasChange(centralCertificateStorePasswordVersionKey) {
+		store.Identity.Password = getWriteOnlyString(d, centralCertificateStorePasswordKey)
+	}
+	if create || d.HasChange(centralCertificateStorePrivateKeyPasswordVersionKey) {
+		store.PrivateKeyPassword = getWriteOnlyString(d, centralCertificateStorePrivateKeyPasswordKey)
+	}
+	return store
+}
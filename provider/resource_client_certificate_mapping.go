@@ -0,0 +1,469 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const oneToOneEnabledKey = "one_to_one_enabled"
+const manyToOneEnabledKey = "many_to_one_enabled"
+const oneToOneKey = "one_to_one"
+const manyToOneKey = "many_to_one"
+const initialEnabledKey = "initial_enabled"
+const initialOneToOneEnabledKey = "initial_one_to_one_enabled"
+const initialManyToOneEnabledKey = "initial_many_to_one_enabled"
+
+const mappingEnabledKey = "enabled"
+const mappingCertificateKey = "certificate"
+const mappingUserNameKey = "user_name"
+const mappingPasswordKey = "password"
+const mappingNameKey = "name"
+const mappingDescriptionKey = "description"
+const mappingPermissionModeKey = "permission_mode"
+const mappingRuleKey = "rule"
+
+const mappingRuleFieldKey = "certificate_field"
+const mappingRuleSubFieldKey = "certificate_sub_field"
+const mappingRuleMatchKey = "match_criteria"
+const mappingRuleCaseSensitiveKey = "compare_case_sensitive"
+
+func resourceClientCertificateMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClientCertificateMappingCreate,
+		ReadContext:   resourceClientCertificateMappingRead,
+		UpdateContext: resourceClientCertificateMappingUpdate,
+		DeleteContext: resourceClientCertificateMappingDelete,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			oneToOneEnabledKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			manyToOneEnabledKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			oneToOneKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        oneToOneMappingSchema,
+				Description: "One-to-one mappings managed by this resource, other mappings of the scope are left in place",
+			},
+			manyToOneKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        manyToOneMappingSchema,
+				Description: "Many-to-one mappings managed by this resource, other mappings of the scope are left in place",
+			},
+			initialEnabledKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Value of enabled before the resource was created, restored on destroy",
+			},
+			initialOneToOneEnabledKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Value of one_to_one_enabled before the resource was created, restored on destroy",
+			},
+			initialManyToOneEnabledKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Value of many_to_one_enabled before the resource was created, restored on destroy",
+			},
+		}),
+	}
+}
+
+var oneToOneMappingSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		mappingEnabledKey: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		mappingCertificateKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Base64 encoded public key of the client certificate",
+		},
+		mappingUserNameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		mappingPasswordKey: {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	},
+}
+
+var manyToOneMappingSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		mappingNameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		mappingDescriptionKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		mappingEnabledKey: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		mappingPermissionModeKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "allow",
+			ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+		},
+		mappingUserNameKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		mappingPasswordKey: {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		mappingRuleKey: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					mappingRuleFieldKey: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					mappingRuleSubFieldKey: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					mappingRuleMatchKey: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					mappingRuleCaseSensitiveKey: {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+	},
+}
+
+func resourceClientCertificateMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating client certificate mapping: "+toJSON(scope))
+	auth, err := client.ReadAuthenticationFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mapping, err := client.ReadClientCertificateMappingAuthentication(ctx, &auth)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	initial := map[string]interface{}{
+		initialEnabledKey:          mapping.Enabled,
+		initialOneToOneEnabledKey:  mapping.OneToOneMappingsEnabled,
+		initialManyToOneEnabledKey: mapping.ManyToOneMappingsEnabled,
+	}
+	for key, value := range initial {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := updateClientCertificateMapping(ctx, d, client, mapping); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created client certificate mapping: "+toJSON(mapping.ID))
+	d.SetId(mapping.ID)
+	return resourceClientCertificateMappingRead(ctx, d, m)
+}
+
+func resourceClientCertificateMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	mapping, err := client.ReadClientCertificateMappingAuthenticationFromId(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read client certificate mapping: "+toJSON(mapping))
+	oneToOne, err := client.ListOneToOneCertificateMappings(ctx, mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	manyToOne, err := client.ListManyToOneCertificateMappings(ctx, mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oneToOne = managedEntries(oneToOne, oneToOneMappingKey, getOneToOneMappings(d.Get(oneToOneKey)))
+	manyToOne = managedEntries(manyToOne, manyToOneMappingKey, getManyToOneMappings(d.Get(manyToOneKey)))
+	if err = d.Set("enabled", mapping.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(oneToOneEnabledKey, mapping.OneToOneMappingsEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(manyToOneEnabledKey, mapping.ManyToOneMappingsEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(oneToOneKey, mapOneToOneMappingsToList(d, oneToOne)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(manyToOneKey, mapManyToOneMappingsToList(d, manyToOne)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceClientCertificateMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating client certificate mapping: "+toJSON(d.Id()))
+	mapping, err := client.ReadClientCertificateMappingAuthenticationFromId(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateClientCertificateMapping(ctx, d, client, mapping); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated client certificate mapping: "+toJSON(d.Id()))
+	return resourceClientCertificateMappingRead(ctx, d, m)
+}
+
+func resourceClientCertificateMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting client certificate mapping: "+toJSON(d.Id()))
+	mapping, err := client.ReadClientCertificateMappingAuthenticationFromId(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncOneToOneMappings(ctx, client, mapping, getOneToOneMappings(d.Get(oneToOneKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncManyToOneMappings(ctx, client, mapping, getManyToOneMappings(d.Get(manyToOneKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	// Only the flags turned on or off by the resource are restored.
+	enabled := d.Get(initialEnabledKey).(bool)
+	oneToOneEnabled := d.Get(initialOneToOneEnabledKey).(bool)
+	manyToOneEnabled := d.Get(initialManyToOneEnabledKey).(bool)
+	if enabled != mapping.Enabled || oneToOneEnabled != mapping.OneToOneMappingsEnabled || manyToOneEnabled != mapping.ManyToOneMappingsEnabled {
+		mapping.Enabled = enabled
+		mapping.OneToOneMappingsEnabled = oneToOneEnabled
+		mapping.ManyToOneMappingsEnabled = manyToOneEnabled
+		if _, err := client.UpdateClientCertificateMappingAuthentication(ctx, mapping); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	tflog.Debug(ctx, "Deleted client certificate mapping: "+toJSON(d.Id()))
+	return nil
+}
+
+func updateClientCertificateMapping(ctx context.Context, d *schema.ResourceData, client *iis.Client, mapping *iis.ClientCertificateMappingAuthentication) error {
+	mapping.Enabled = d.Get("enabled").(bool)
+	mapping.OneToOneMappingsEnabled = d.Get(oneToOneEnabledKey).(bool)
+	mapping.ManyToOneMappingsEnabled = d.Get(manyToOneEnabledKey).(bool)
+	if _, err := client.UpdateClientCertificateMappingAuthentication(ctx, mapping); err != nil {
+		return err
+	}
+	if d.IsNewResource() || d.HasChange(oneToOneKey) {
+		previous, desired := d.GetChange(oneToOneKey)
+		if err := syncOneToOneMappings(ctx, client, mapping, getOneToOneMappings(previous), getOneToOneMappings(desired)); err != nil {
+			return err
+		}
+	}
+	if d.IsNewResource() || d.HasChange(manyToOneKey) {
+		previous, desired := d.GetChange(manyToOneKey)
+		if err := syncManyToOneMappings(ctx, client, mapping, getManyToOneMappings(previous), getManyToOneMappings(desired)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncOneToOneMappings replaces the previously managed mappings by the desired
+// ones, leaving mappings which are not managed by the resource in place. IIS
+// never returns the mapped passwords, so the previous ones are assumed for
+// the existing mappings, replacing mappings whose password changed.
+func syncOneToOneMappings(ctx context.Context, client *iis.Client, auth *iis.ClientCertificateMappingAuthentication, previous, desired []iis.OneToOneCertificateMapping) error {
+	existing, err := client.ListOneToOneCertificateMappings(ctx, auth)
+	if err != nil {
+		return err
+	}
+	passwords := make(map[string]string)
+	for _, mapping := range previous {
+		passwords[oneToOneMappingKey(mapping)] = mapping.Password
+	}
+	existing = managedEntries(existing, oneToOneMappingKey, previous, desired)
+	for i := range existing {
+		existing[i].Password = passwords[oneToOneMappingKey(existing[i])]
+	}
+	return syncCollection(existing, desired, oneToOneMappingContent,
+		func(mapping iis.OneToOneCertificateMapping) error {
+			return client.DeleteOneToOneCertificateMapping(ctx, mapping.ID)
+		},
+		func(mapping iis.OneToOneCertificateMapping) error {
+			_, err := client.CreateOneToOneCertificateMapping(ctx, auth, mapping)
+			return err
+		})
+}
+
+// syncManyToOneMappings works like syncOneToOneMappings for many-to-one
+// mappings, which are identified by their name.
+func syncManyToOneMappings(ctx context.Context, client *iis.Client, auth *iis.ClientCertificateMappingAuthentication, previous, desired []iis.ManyToOneCertificateMapping) error {
+	existing, err := client.ListManyToOneCertificateMappings(ctx, auth)
+	if err != nil {
+		return err
+	}
+	passwords := make(map[string]string)
+	for _, mapping := range previous {
+		passwords[manyToOneMappingKey(mapping)] = mapping.Password
+	}
+	existing = managedEntries(existing, manyToOneMappingKey, previous, desired)
+	for i := range existing {
+		existing[i].Password = passwords[manyToOneMappingKey(existing[i])]
+	}
+	return syncCollection(existing, desired, manyToOneMappingContent,
+		func(mapping iis.ManyToOneCertificateMapping) error {
+			return client.DeleteManyToOneCertificateMapping(ctx, mapping.ID)
+		},
+		func(mapping iis.ManyToOneCertificateMapping) error {
+			_, err := client.CreateManyToOneCertificateMapping(ctx, auth, mapping)
+			return err
+		})
+}
+
+// The *Key functions identify a mapping, while the *Content functions also
+// cover its settings, so changed mappings get replaced.
+func oneToOneMappingKey(mapping iis.OneToOneCertificateMapping) string {
+	return mapping.Certificate
+}
+
+func oneToOneMappingContent(mapping iis.OneToOneCertificateMapping) string {
+	return fmt.Sprintf("%s|%t|%s|%s", oneToOneMappingKey(mapping), mapping.Enabled, mapping.UserName, mapping.Password)
+}
+
+func manyToOneMappingKey(mapping iis.ManyToOneCertificateMapping) string {
+	return strings.ToLower(mapping.Name)
+}
+
+func manyToOneMappingContent(mapping iis.ManyToOneCertificateMapping) string {
+	rules := make([]string, len(mapping.Rules))
+	for i, rule := range mapping.Rules {
+		rules[i] = fmt.Sprintf("%s:%s:%s:%t", rule.CertificateField, rule.CertificateSubField, rule.MatchCriteria, rule.CompareCaseSensitive)
+	}
+	return fmt.Sprintf("%s|%s|%t|%s|%s|%s|%s", manyToOneMappingKey(mapping), mapping.Description, mapping.Enabled,
+		mapping.PermissionMode, mapping.UserName, mapping.Password, strings.Join(rules, ";"))
+}
+
+func getOneToOneMappings(v interface{}) []iis.OneToOneCertificateMapping {
+	entries := v.([]interface{})
+	mappings := make([]iis.OneToOneCertificateMapping, len(entries))
+	for i, entry := range entries {
+		mapping := entry.(map[string]interface{})
+		mappings[i] = iis.OneToOneCertificateMapping{
+			Enabled:     mapping[mappingEnabledKey].(bool),
+			Certificate: mapping[mappingCertificateKey].(string),
+			UserName:    mapping[mappingUserNameKey].(string),
+			Password:    mapping[mappingPasswordKey].(string),
+		}
+	}
+	return mappings
+}
+
+func getManyToOneMappings(v interface{}) []iis.ManyToOneCertificateMapping {
+	entries := v.([]interface{})
+	mappings := make([]iis.ManyToOneCertificateMapping, len(entries))
+	for i, entry := range entries {
+		mapping := entry.(map[string]interface{})
+		ruleEntries := mapping[mappingRuleKey].([]interface{})
+		rules := make([]iis.ManyToOneCertificateMappingRule, len(ruleEntries))
+		for j, ruleEntry := range ruleEntries {
+			rule := ruleEntry.(map[string]interface{})
+			rules[j] = iis.ManyToOneCertificateMappingRule{
+				CertificateField:     rule[mappingRuleFieldKey].(string),
+				CertificateSubField:  rule[mappingRuleSubFieldKey].(string),
+				MatchCriteria:        rule[mappingRuleMatchKey].(string),
+				CompareCaseSensitive: rule[mappingRuleCaseSensitiveKey].(bool),
+			}
+		}
+		mappings[i] = iis.ManyToOneCertificateMapping{
+			Name:           mapping[mappingNameKey].(string),
+			Description:    mapping[mappingDescriptionKey].(string),
+			Enabled:        mapping[mappingEnabledKey].(bool),
+			PermissionMode: mapping[mappingPermissionModeKey].(string),
+			UserName:       mapping[mappingUserNameKey].(string),
+			Password:       mapping[mappingPasswordKey].(string),
+			Rules:          rules,
+		}
+	}
+	return mappings
+}
+
+// IIS never returns the mapped passwords, so they are carried over from the
+// current state for mappings which are still present.
+func mapOneToOneMappingsToList(d *schema.ResourceData, mappings []iis.OneToOneCertificateMapping) []interface{} {
+	passwords := make(map[string]string)
+	for _, mapping := range getOneToOneMappings(d.Get(oneToOneKey)) {
+		passwords[mapping.Certificate] = mapping.Password
+	}
+	list := make([]interface{}, len(mappings))
+	for i, mapping := range mappings {
+		list[i] = map[string]interface{}{
+			mappingEnabledKey:     mapping.Enabled,
+			mappingCertificateKey: mapping.Certificate,
+			mappingUserNameKey:    mapping.UserName,
+			mappingPasswordKey:    passwords[mapping.Certificate],
+		}
+	}
+	return list
+}
+
+func mapManyToOneMappingsToList(d *schema.ResourceData, mappings []iis.ManyToOneCertificateMapping) []interface{} {
+	passwords := make(map[string]string)
+	for _, mapping := range getManyToOneMappings(d.Get(manyToOneKey)) {
+		passwords[mapping.Name] = mapping.Password
+	}
+	list := make([]interface{}, len(mappings))
+	for i, mapping := range mappings {
+		rules := make([]interface{}, len(mapping.Rules))
+		for j, rule := range mapping.Rules {
+			rules[j] = map[string]interface{}{
+				mappingRuleFieldKey:         rule.CertificateField,
+				mappingRuleSubFieldKey:      rule.CertificateSubField,
+				mappingRuleMatchKey:         rule.MatchCriteria,
+				mappingRuleCaseSensitiveKey: rule.CompareCaseSensitive,
+			}
+		}
+		list[i] = map[string]interface{}{
+			mappingNameKey:           mapping.Name,
+			mappingDescriptionKey:    mapping.Description,
+			mappingEnabledKey:        mapping.Enabled,
+			mappingPermissionModeKey: mapping.PermissionMode,
+			mappingUserNameKey:       mapping.UserName,
+			mappingPasswordKey:       passwords[mapping.Name],
+			mappingRuleKey:           rules,
+		}
+	}
+	return list
+}
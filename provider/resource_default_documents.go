@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const defaultDocumentFilesKey = "files"
+
+func resourceDefaultDocuments() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultDocumentsCreate,
+		ReadContext:   resourceDefaultDocumentsRead,
+		UpdateContext: resourceDefaultDocumentsUpdate,
+		DeleteContext: resourceDefaultDocumentsDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			defaultDocumentFilesKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "File names in the order IIS should try them. The files are added after inherited and unmanaged entries, so the order only applies among the managed files",
+			},
+		}),
+	}
+}
+
+func resourceDefaultDocumentsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating default documents: "+toJSON(scope))
+	document, err := client.ReadDefaultDocumentFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateDefaultDocuments(ctx, d, client, document); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created default documents: "+toJSON(document))
+	d.SetId(document.ID)
+	return resourceDefaultDocumentsRead(ctx, d, m)
+}
+
+func resourceDefaultDocumentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	document, err := client.ReadDefaultDocument(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read default documents: "+toJSON(document))
+	files, err := client.ListDefaultDocumentFiles(ctx, document)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", document.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(defaultDocumentFilesKey, managedDefaultDocumentNames(files, toStringList(getList(d, defaultDocumentFilesKey)))); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDefaultDocumentsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating default documents: "+toJSON(d.Id()))
+	document, err := client.ReadDefaultDocument(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateDefaultDocuments(ctx, d, client, document); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated default documents: "+toJSON(d.Id()))
+	return resourceDefaultDocumentsRead(ctx, d, m)
+}
+
+func resourceDefaultDocumentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting default documents: "+toJSON(d.Id()))
+	document, err := client.ReadDefaultDocument(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	files, err := client.ListDefaultDocumentFiles(ctx, document)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managed := toStringList(getList(d, defaultDocumentFilesKey))
+	for _, file := range files {
+		if containsFold(managed, file.Name) {
+			if err := client.DeleteDefaultDocumentFile(ctx, file.ID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	tflog.Debug(ctx, "Deleted default documents: "+toJSON(d.Id()))
+	return nil
+}
+
+// managedDefaultDocumentNames returns the managed files in their effective
+// order. Managed files following an unmanaged entry, e.g. after reordering
+// them in IIS Manager, are left out, so the drift is planned to be fixed.
+func managedDefaultDocumentNames(files []iis.DefaultDocumentFile, managed []string) []string {
+	var names []string
+	interrupted := false
+	for _, file := range files {
+		switch {
+		case !containsFold(managed, file.Name):
+			interrupted = len(names) > 0
+		case !interrupted:
+			names = append(names, file.Name)
+		}
+	}
+	return names
+}
+
+func updateDefaultDocuments(ctx context.Context, d *schema.ResourceData, client *iis.Client, document *iis.DefaultDocument) error {
+	enabled := d.Get("enabled").(bool)
+	if enabled != document.Enabled {
+		if _, err := client.UpdateDefaultDocument(ctx, document.ID, enabled); err != nil {
+			return err
+		}
+	}
+	if !d.IsNewResource() && !d.HasChange(defaultDocumentFilesKey) {
+		return nil
+	}
+	// Managed entries are always recreated, as the API offers no way to move
+	// an existing entry to another position. Inherited and unmanaged entries
+	// are left in place.
+	files, err := client.ListDefaultDocumentFiles(ctx, document)
+	if err != nil {
+		return err
+	}
+	previous, desired := d.GetChange(defaultDocumentFilesKey)
+	managed := append(toStringList(previous.([]interface{})), toStringList(desired.([]interface{}))...)
+	for _, file := range files {
+		if !containsFold(managed, file.Name) {
+			continue
+		}
+		if err := client.DeleteDefaultDocumentFile(ctx, file.ID); err != nil {
+			return err
+		}
+	}
+	for _, name := range toStringList(getList(d, defaultDocumentFilesKey)) {
+		if _, err := client.CreateDefaultDocumentFile(ctx, document, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
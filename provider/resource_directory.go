@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const pathKey = "path"
+const deleteOnDestroyKey = "delete_on_destroy"
+
+func resourceDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryCreate,
+		ReadContext:   resourceDirectoryRead,
+		UpdateContext: resourceDirectoryUpdate,
+		DeleteContext: resourceDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importDirectory,
+		},
+
+		Schema: map[string]*schema.Schema{
+			pathKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Physical path of the directory, missing parents are created as well",
+			},
+			deleteOnDestroyKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the directory along with its content when the resource is destroyed",
+			},
+		},
+	}
+}
+
+// importDirectory imports a directory by its physical path.
+func importDirectory(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*providerMeta).client
+	path := windowsPath(d.Id())
+	directory, err := client.FindFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if directory == nil {
+		return nil, fmt.Errorf("directory %s not found", path)
+	}
+	if err := d.Set(pathKey, path); err != nil {
+		return nil, err
+	}
+	if err := d.Set(deleteOnDestroyKey, false); err != nil {
+		return nil, err
+	}
+	d.SetId(directory.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	path := windowsPath(d.Get(pathKey).(string))
+	tflog.Debug(ctx, "Creating directory: "+toJSON(path))
+	existing, err := client.FindFile(ctx, path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if existing != nil {
+		// Adopting the directory would delete it on destroy, although it
+		// was not created by terraform.
+		return diag.Errorf("directory %s already exists, use terraform import to manage it", path)
+	}
+	directory, err := ensureDirectory(ctx, client, path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created directory: "+toJSON(directory))
+	d.SetId(directory.ID)
+	return resourceDirectoryRead(ctx, d, m)
+}
+
+func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	directory, err := client.ReadFile(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read directory: "+toJSON(directory))
+	if !strings.EqualFold(windowsPath(d.Get(pathKey).(string)), windowsPath(directory.PhysicalPath)) {
+		if err = d.Set(pathKey, directory.PhysicalPath); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// resourceDirectoryUpdate only has to store delete_on_destroy, as the path
+// forces a new directory.
+func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceDirectoryRead(ctx, d, m)
+}
+
+func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get(deleteOnDestroyKey).(bool) {
+		return nil
+	}
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting directory: "+toJSON(id))
+	if err := client.DeleteFile(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted directory: "+toJSON(id))
+	return nil
+}
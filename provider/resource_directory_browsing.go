@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const showDateKey = "show_date"
+const showTimeKey = "show_time"
+const showSizeKey = "show_size"
+const showExtensionKey = "show_extension"
+const showLongDateKey = "show_long_date"
+
+func resourceDirectoryBrowsing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryBrowsingCreate,
+		ReadContext:   resourceDirectoryBrowsingRead,
+		UpdateContext: resourceDirectoryBrowsingUpdate,
+		DeleteContext: resourceDirectoryBrowsingDelete,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			showDateKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			showTimeKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			showSizeKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			showExtensionKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			showLongDateKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		}),
+	}
+}
+
+func resourceDirectoryBrowsingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating directory browsing: "+toJSON(scope))
+	browsing, err := client.ReadDirectoryBrowsingFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	browsing, err = client.UpdateDirectoryBrowsing(ctx, getDirectoryBrowsing(d, browsing.ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created directory browsing: "+toJSON(browsing))
+	d.SetId(browsing.ID)
+	return resourceDirectoryBrowsingRead(ctx, d, m)
+}
+
+func resourceDirectoryBrowsingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	browsing, err := client.ReadDirectoryBrowsing(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read directory browsing: "+toJSON(browsing))
+	values := map[string]bool{
+		"enabled":        browsing.Enabled,
+		showDateKey:      browsing.AllowedAttributes.Date,
+		showTimeKey:      browsing.AllowedAttributes.Time,
+		showSizeKey:      browsing.AllowedAttributes.Size,
+		showExtensionKey: browsing.AllowedAttributes.Extension,
+		showLongDateKey:  browsing.AllowedAttributes.LongDate,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceDirectoryBrowsingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getDirectoryBrowsing(d, d.Id())
+	tflog.Debug(ctx, "Updating directory browsing: "+toJSON(request))
+	browsing, err := client.UpdateDirectoryBrowsing(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated directory browsing: "+toJSON(browsing))
+	return resourceDirectoryBrowsingRead(ctx, d, m)
+}
+
+func resourceDirectoryBrowsingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting directory browsing: "+toJSON(d.Id()))
+	browsing, err := client.ReadDirectoryBrowsing(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	browsing.Enabled = false
+	if _, err := client.UpdateDirectoryBrowsing(ctx, browsing); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted directory browsing: "+toJSON(d.Id()))
+	return nil
+}
+
+func getDirectoryBrowsing(d *schema.ResourceData, id string) *iis.DirectoryBrowsing {
+	return &iis.DirectoryBrowsing{
+		ID:      id,
+		Enabled: d.Get("enabled").(bool),
+		AllowedAttributes: iis.DirectoryBrowsingAttributes{
+			Date:      d.Get(showDateKey).(bool),
+			Time:      d.Get(showTimeKey).(bool),
+			Size:      d.Get(showSizeKey).(bool),
+			Extension: d.Get(showExtensionKey).(bool),
+			LongDate:  d.Get(showLongDateKey).(bool),
+		},
+	}
+}
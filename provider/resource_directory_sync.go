@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const syncSourceKey = "source"
+const syncDestinationKey = "destination"
+const syncDeleteExtraKey = "delete_extra"
+const syncConcurrencyKey = "concurrency"
+const syncFilesKey = "files"
+const syncExcludeKey = "exclude"
+
+// defaultSyncExcludes keeps the web.config written by the site scoped
+// resources, e.g. iis_response_headers, from being deleted as extra file.
+var defaultSyncExcludes = []interface{}{"web.config"}
+
+func resourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectorySyncCreate,
+		ReadContext:   resourceDirectorySyncRead,
+		UpdateContext: resourceDirectorySyncUpdate,
+		DeleteContext: resourceDirectorySyncDelete,
+		CustomizeDiff: diffDirectorySync,
+
+		Schema: map[string]*schema.Schema{
+			syncSourceKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local directory to upload",
+			},
+			syncDestinationKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Physical path of the directory on the server, e.g. the physical path of a website",
+			},
+			syncDeleteExtraKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete files on the server which are not part of the source. Every file of the destination is downloaded and hashed on each refresh then",
+			},
+			syncExcludeKey: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Patterns of files which are neither uploaded nor deleted, patterns without a slash match the file name in every directory. Defaults to web.config",
+			},
+			syncConcurrencyKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			deleteOnDestroyKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the synced files when the resource is destroyed",
+			},
+			syncFilesKey: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "SHA-256 hashes of the files on the server by their path relative to the destination",
+			},
+		},
+	}
+}
+
+// remoteFile is a file below the destination of a directory sync.
+type remoteFile struct {
+	id   string
+	hash string
+}
+
+// diffDirectorySync plans the files map from the hashes of the local files,
+// so the plan lists every file which is added, changed or deleted.
+func diffDirectorySync(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	exclude := d.GetRawConfig().GetAttr(syncExcludeKey)
+	if !exclude.IsWhollyKnown() {
+		if err := d.SetNewComputed(syncExcludeKey); err != nil {
+			return err
+		}
+		return d.SetNewComputed(syncFilesKey)
+	}
+	excludes := defaultSyncExcludes
+	if !exclude.IsNull() {
+		excludes = nil
+		for it := exclude.ElementIterator(); it.Next(); {
+			_, pattern := it.Element()
+			excludes = append(excludes, pattern.AsString())
+		}
+	}
+	if !d.Get(syncExcludeKey).(*schema.Set).Equal(schema.NewSet(schema.HashString, excludes)) {
+		if err := d.SetNew(syncExcludeKey, excludes); err != nil {
+			return err
+		}
+	}
+	if !d.NewValueKnown(syncSourceKey) || !d.NewValueKnown(syncDeleteExtraKey) {
+		return d.SetNewComputed(syncFilesKey)
+	}
+	local, err := hashLocalDirectory(d.Get(syncSourceKey).(string), getSyncExcludes(d.Get(syncExcludeKey)))
+	if err != nil {
+		return err
+	}
+	current := d.Get(syncFilesKey).(map[string]interface{})
+	desired := make(map[string]interface{}, len(local))
+	for name, hash := range local {
+		desired[name] = hash
+	}
+	if !d.Get(syncDeleteExtraKey).(bool) {
+		for name, hash := range current {
+			if _, ok := desired[name]; !ok {
+				desired[name] = hash
+			}
+		}
+	}
+	if !reflect.DeepEqual(current, desired) {
+		return d.SetNew(syncFilesKey, desired)
+	}
+	return nil
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	destination := windowsPath(d.Get(syncDestinationKey).(string))
+	tflog.Debug(ctx, "Creating directory sync: "+toJSON(destination))
+	directory, err := ensureDirectory(ctx, client, destination)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncDirectory(ctx, d, client, directory); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created directory sync: "+toJSON(directory.ID))
+	d.SetId(directory.ID)
+	return resourceDirectorySyncRead(ctx, d, m)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	directory, err := client.ReadFile(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	managed := managedSyncFiles(d)
+	files, err := scanRemoteDirectory(ctx, client, directory, d.Get(syncConcurrencyKey).(int), managed)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read directory sync: "+toJSON(len(files)))
+	hashes := make(map[string]interface{}, len(files))
+	for name, file := range files {
+		if managed(name) {
+			hashes[name] = file.hash
+		}
+	}
+	if err = d.Set(syncFilesKey, hashes); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating directory sync: "+toJSON(d.Id()))
+	directory, err := client.ReadFile(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncDirectory(ctx, d, client, directory); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated directory sync: "+toJSON(d.Id()))
+	return resourceDirectorySyncRead(ctx, d, m)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get(deleteOnDestroyKey).(bool) {
+		return nil
+	}
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting directory sync: "+toJSON(d.Id()))
+	directory, err := client.ReadFile(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	files, err := listRemoteDirectory(ctx, client, directory, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var jobs []func() error
+	for name := range d.Get(syncFilesKey).(map[string]interface{}) {
+		if file, ok := files[name]; ok {
+			jobs = append(jobs, func() error {
+				return client.DeleteFile(ctx, file.id)
+			})
+		}
+	}
+	if err := runParallel(d.Get(syncConcurrencyKey).(int), jobs); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted directory sync: "+toJSON(d.Id()))
+	return nil
+}
+
+// syncDirectory uploads new and changed files to the directory and deletes
+// extra files if configured to.
+func syncDirectory(ctx context.Context, d *schema.ResourceData, client *iis.Client, directory *iis.File) error {
+	source := d.Get(syncSourceKey).(string)
+	concurrency := d.Get(syncConcurrencyKey).(int)
+	excludes := getSyncExcludes(d.Get(syncExcludeKey))
+	local, err := hashLocalDirectory(source, excludes)
+	if err != nil {
+		return err
+	}
+	remote, err := scanRemoteDirectory(ctx, client, directory, concurrency, func(name string) bool {
+		_, ok := local[name]
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+	directories := map[string]*iis.File{"": directory}
+	var jobs []func() error
+	for name, hash := range local {
+		file, exists := remote[name]
+		if exists && file.hash == hash {
+			continue
+		}
+		dir, fileName := path.Split(name)
+		dir = strings.TrimSuffix(dir, "/")
+		parent, ok := directories[dir]
+		if !ok {
+			parent, err = ensureDirectory(ctx, client, directory.PhysicalPath+`\`+filepath.FromSlash(dir))
+			if err != nil {
+				return err
+			}
+			directories[dir] = parent
+		}
+		localPath := filepath.Join(source, filepath.FromSlash(name))
+		jobs = append(jobs, func() error {
+			content, err := os.ReadFile(localPath)
+			if err != nil {
+				return err
+			}
+			id := file.id
+			if !exists {
+				created, err := client.CreateFile(ctx, parent, fileName, iis.FileTypeFile)
+				if err != nil {
+					return err
+				}
+				id = created.ID
+			}
+			tflog.Debug(ctx, "Uploading file: "+toJSON(name))
+			return client.WriteFileContent(ctx, id, content)
+		})
+	}
+	if d.Get(syncDeleteExtraKey).(bool) {
+		for name, file := range remote {
+			if _, ok := local[name]; ok || excludedSyncFile(excludes, name) {
+				continue
+			}
+			jobs = append(jobs, func() error {
+				tflog.Debug(ctx, "Deleting file: "+toJSON(name))
+				return client.DeleteFile(ctx, file.id)
+			})
+		}
+	}
+	return runParallel(concurrency, jobs)
+}
+
+// managedSyncFiles tells which files on the server belong to the sync: all
+// files which are not excluded when extra files are deleted, otherwise the
+// ones found in the source or uploaded before. As managed files are hashed
+// while reading, deleting extra files downloads the whole destination on
+// every refresh.
+func managedSyncFiles(d *schema.ResourceData) func(string) bool {
+	excludes := getSyncExcludes(d.Get(syncExcludeKey))
+	if d.Get(syncDeleteExtraKey).(bool) {
+		return func(name string) bool { return !excludedSyncFile(excludes, name) }
+	}
+	previous := d.Get(syncFilesKey).(map[string]interface{})
+	local, err := hashLocalDirectory(d.Get(syncSourceKey).(string), excludes)
+	if err != nil {
+		local = nil
+	}
+	return func(name string) bool {
+		_, uploaded := previous[name]
+		_, found := local[name]
+		return (uploaded || found) && !excludedSyncFile(excludes, name)
+	}
+}
+
+func getSyncExcludes(v interface{}) []string {
+	return toStringList(v.(*schema.Set).List())
+}
+
+// excludedSyncFile reports whether the slash separated path matches one of
+// the patterns, where patterns without a slash only match the file name.
+func excludedSyncFile(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		subject := name
+		if !strings.Contains(pattern, "/") {
+			subject = path.Base(name)
+		}
+		if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(subject)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// hashLocalDirectory hashes all files below root which are not excluded by
+// their slash separated path relative to root.
+func hashLocalDirectory(root string, excludes []string) (map[string]string, error) {
+	hashes := make(map[string]string)
+	err := filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		name, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if excludedSyncFile(excludes, name) {
+			return nil
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		hashes[name] = hashContent(content)
+		return nil
+	})
+	return hashes, err
+}
+
+// listRemoteDirectory lists all files below directory by their slash
+// separated path relative to it, without hashing them.
+func listRemoteDirectory(ctx context.Context, client *iis.Client, directory *iis.File, prefix string) (map[string]remoteFile, error) {
+	entries, err := client.ListFiles(ctx, directory)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]remoteFile)
+	for _, entry := range entries {
+		if entry.Type != iis.FileTypeDirectory {
+			files[prefix+entry.Name] = remoteFile{id: entry.ID}
+			continue
+		}
+		subdirectory, err := client.ReadFile(ctx, entry.ID)
+		if err != nil {
+			return nil, err
+		}
+		children, err := listRemoteDirectory(ctx, client, subdirectory, prefix+entry.Name+"/")
+		if err != nil {
+			return nil, err
+		}
+		for name, file := range children {
+			files[name] = file
+		}
+	}
+	return files, nil
+}
+
+// scanRemoteDirectory lists all files below directory and hashes the content
+// of the ones selected by hash, downloading up to concurrency files at a time.
+func scanRemoteDirectory(ctx context.Context, client *iis.Client, directory *iis.File, concurrency int, hash func(string) bool) (map[string]remoteFile, error) {
+	files, err := listRemoteDirectory(ctx, client, directory, "")
+	if err != nil {
+		return nil, err
+	}
+	var mutex sync.Mutex
+	var jobs []func() error
+	for name, file := range files {
+		if !hash(name) {
+			continue
+		}
+		jobs = append(jobs, func() error {
+			content, err := client.ReadFileContent(ctx, file.id)
+			if err != nil {
+				return err
+			}
+			mutex.Lock()
+			files[name] = remoteFile{id: file.id, hash: hashContent(content)}
+			mutex.Unlock()
+			return nil
+		})
+	}
+	return files, runParallel(concurrency, jobs)
+}
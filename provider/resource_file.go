@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const fileContentKey = "content"
+const fileSourceKey = "source"
+const fileContentHashKey = "content_sha256"
+
+func resourceFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFileCreate,
+		ReadContext:   resourceFileRead,
+		UpdateContext: resourceFileUpdate,
+		DeleteContext: resourceFileDelete,
+		CustomizeDiff: diffFileContent,
+		Importer: &schema.ResourceImporter{
+			StateContext: importFile,
+		},
+
+		Schema: map[string]*schema.Schema{
+			pathKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Physical path of the file, missing parent directories are created as well",
+			},
+			fileContentKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{fileContentKey, fileSourceKey},
+			},
+			fileSourceKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{fileContentKey, fileSourceKey},
+				Description:  "Local file to upload",
+			},
+			fileContentHashKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the file content on the server",
+			},
+		},
+	}
+}
+
+// importFile imports a file by its physical path, its content is replaced by
+// the configured one on the next apply.
+func importFile(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*providerMeta).client
+	path := windowsPath(d.Id())
+	file, err := client.FindFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil || file.Type != iis.FileTypeFile {
+		return nil, fmt.Errorf("file %s not found", path)
+	}
+	if err := d.Set(pathKey, path); err != nil {
+		return nil, err
+	}
+	d.SetId(file.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// diffFileContent compares the hash of the local content with the one of the
+// file on the server, so changes of the source file or on the server are
+// detected as well.
+func diffFileContent(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(fileContentKey) || !d.NewValueKnown(fileSourceKey) {
+		return d.SetNewComputed(fileContentHashKey)
+	}
+	content, err := readFileContent(d.Get(fileContentKey).(string), d.Get(fileSourceKey).(string))
+	if err != nil {
+		return err
+	}
+	if hash := hashContent(content); hash != d.Get(fileContentHashKey).(string) {
+		return d.SetNew(fileContentHashKey, hash)
+	}
+	return nil
+}
+
+func resourceFileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	path := windowsPath(d.Get(pathKey).(string))
+	content, err := readFileContent(d.Get(fileContentKey).(string), d.Get(fileSourceKey).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Creating file: "+toJSON(path))
+	existing, err := client.FindFile(ctx, path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if existing != nil {
+		return diag.Errorf("file %s already exists, use terraform import to manage it", path)
+	}
+	parentPath, name := splitWindowsPath(path)
+	parent, err := ensureDirectory(ctx, client, parentPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	file, err := client.CreateFile(ctx, parent, name, iis.FileTypeFile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.WriteFileContent(ctx, file.ID, content); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created file: "+toJSON(file))
+	d.SetId(file.ID)
+	return resourceFileRead(ctx, d, m)
+}
+
+func resourceFileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	file, err := client.ReadFile(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read file: "+toJSON(file))
+	content, err := client.ReadFileContent(ctx, file.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !strings.EqualFold(windowsPath(d.Get(pathKey).(string)), windowsPath(file.PhysicalPath)) {
+		if err = d.Set(pathKey, file.PhysicalPath); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set(fileContentHashKey, hashContent(content)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	content, err := readFileContent(d.Get(fileContentKey).(string), d.Get(fileSourceKey).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updating file: "+toJSON(d.Id()))
+	if err := client.WriteFileContent(ctx, d.Id(), content); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated file: "+toJSON(d.Id()))
+	return resourceFileRead(ctx, d, m)
+}
+
+func resourceFileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting file: "+toJSON(id))
+	if err := client.DeleteFile(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted file: "+toJSON(id))
+	return nil
+}
+
+// readFileContent returns the inline content or the content of the local
+// source file.
+func readFileContent(content, source string) ([]byte, error) {
+	if source == "" {
+		return []byte(content), nil
+	}
+	return os.ReadFile(source)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const imageKey = "image"
+const bitnessKey = "bitness"
+
+func resourceGlobalModule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGlobalModuleCreate,
+		ReadContext:   resourceGlobalModuleRead,
+		UpdateContext: resourceGlobalModuleUpdate,
+		DeleteContext: resourceGlobalModuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			nameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			imageKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the native module dll on the server",
+			},
+			bitnessKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Restricts the module to 32 or 64 bit worker processes",
+				ValidateFunc: validation.StringInSlice([]string{"32", "64"}, false),
+			},
+		},
+	}
+}
+
+func resourceGlobalModuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getGlobalModule(d, "")
+	tflog.Debug(ctx, "Creating global module: "+toJSON(request))
+	module, err := client.CreateGlobalModule(ctx, *request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created global module: "+toJSON(module))
+	d.SetId(module.ID)
+	return resourceGlobalModuleRead(ctx, d, m)
+}
+
+func resourceGlobalModuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	module, err := client.ReadGlobalModule(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read global module: "+toJSON(module))
+	if err = d.Set(nameKey, module.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(imageKey, module.Image); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(bitnessKey, bitnessFromPrecondition(module.Precondition)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGlobalModuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	existing, err := client.ReadGlobalModule(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getGlobalModule(d, existing.Precondition)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating global module: "+toJSON(request))
+	module, err := client.UpdateGlobalModule(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated global module: "+toJSON(module))
+	d.SetId(module.ID)
+	return resourceGlobalModuleRead(ctx, d, m)
+}
+
+func resourceGlobalModuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting global module: "+toJSON(id))
+	if err := client.DeleteGlobalModule(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted global module: "+toJSON(id))
+	return nil
+}
+
+func getGlobalModule(d *schema.ResourceData, precondition string) *iis.GlobalModule {
+	return &iis.GlobalModule{
+		Name:         d.Get(nameKey).(string),
+		Image:        d.Get(imageKey).(string),
+		Precondition: preconditionWithBitness(precondition, d.Get(bitnessKey).(string)),
+	}
+}
+
+// preconditionWithBitness replaces the bitness token of a precondition while
+// keeping any other conditions, e.g. runtimeVersion, which were set outside
+// of terraform.
+func preconditionWithBitness(precondition string, bitness string) string {
+	var conditions []string
+	for _, condition := range strings.Split(precondition, ",") {
+		switch strings.TrimSpace(strings.ToLower(condition)) {
+		case "", "bitness32", "bitness64":
+			continue
+		}
+		conditions = append(conditions, strings.TrimSpace(condition))
+	}
+	if bitness != "" {
+		conditions = append(conditions, "bitness"+bitness)
+	}
+	return strings.Join(conditions, ",")
+}
+
+func bitnessFromPrecondition(precondition string) string {
+	for _, condition := range strings.Split(precondition, ",") {
+		switch strings.TrimSpace(strings.ToLower(condition)) {
+		case "bitness32":
+			return "32"
+		case "bitness64":
+			return "64"
+		}
+	}
+	return ""
+}
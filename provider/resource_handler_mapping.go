@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const handlerPathKey = "path"
+const handlerVerbsKey = "verbs"
+const handlerTypeKey = "type"
+const handlerModulesKey = "modules"
+const scriptProcessorKey = "script_processor"
+const resourceTypeKey = "resource_type"
+const requireAccessKey = "require_access"
+const preconditionKey = "precondition"
+
+func resourceHandlerMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHandlerMappingCreate,
+		ReadContext:   resourceHandlerMappingRead,
+		UpdateContext: resourceHandlerMappingUpdate,
+		DeleteContext: resourceHandlerMappingDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			nameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			handlerPathKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			handlerVerbsKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+			handlerTypeKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Managed handler type, e.g. System.Web.UI.PageHandlerFactory",
+			},
+			handlerModulesKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Native modules handling the request, e.g. FastCgiModule or IsapiModule",
+			},
+			scriptProcessorKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			resourceTypeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Unspecified",
+				ValidateFunc: validation.StringInSlice([]string{"File", "Directory", "Either", "Unspecified"}, false),
+			},
+			requireAccessKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Script",
+				ValidateFunc: validation.StringInSlice([]string{"None", "Read", "Write", "Script", "Execute"}, false),
+			},
+			preconditionKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		}),
+	}
+}
+
+func resourceHandlerMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	handlers, err := client.ReadHandlersFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getHandlerMapping(d)
+	tflog.Debug(ctx, "Creating handler mapping: "+toJSON(request))
+	mapping, err := client.CreateHandlerMapping(ctx, handlers, *request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created handler mapping: "+toJSON(mapping))
+	d.SetId(mapping.ID)
+	return resourceHandlerMappingRead(ctx, d, m)
+}
+
+func resourceHandlerMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	mapping, err := client.ReadHandlerMapping(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read handler mapping: "+toJSON(mapping))
+	values := map[string]interface{}{
+		nameKey:            mapping.Name,
+		handlerPathKey:     mapping.Path,
+		handlerVerbsKey:    mapping.Verbs,
+		handlerTypeKey:     mapping.Type,
+		handlerModulesKey:  mapping.Modules,
+		scriptProcessorKey: mapping.ScriptProcessor,
+		resourceTypeKey:    mapping.ResourceType,
+		requireAccessKey:   mapping.RequireAccess,
+		preconditionKey:    mapping.Precondition,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceHandlerMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getHandlerMapping(d)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating handler mapping: "+toJSON(request))
+	mapping, err := client.UpdateHandlerMapping(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated handler mapping: "+toJSON(mapping))
+	d.SetId(mapping.ID)
+	return resourceHandlerMappingRead(ctx, d, m)
+}
+
+func resourceHandlerMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting handler mapping: "+toJSON(id))
+	if err := client.DeleteHandlerMapping(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted handler mapping: "+toJSON(id))
+	return nil
+}
+
+func getHandlerMapping(d *schema.ResourceData) *iis.HandlerMapping {
+	return &iis.HandlerMapping{
+		Name:            d.Get(nameKey).(string),
+		Path:            d.Get(handlerPathKey).(string),
+		Verbs:           d.Get(handlerVerbsKey).(string),
+		Type:            d.Get(handlerTypeKey).(string),
+		Modules:         d.Get(handlerModulesKey).(string),
+		ScriptProcessor: d.Get(scriptProcessorKey).(string),
+		ResourceType:    d.Get(resourceTypeKey).(string),
+		RequireAccess:   d.Get(requireAccessKey).(string),
+		Precondition:    d.Get(preconditionKey).(string),
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const accessReadKey = "read"
+const accessScriptKey = "script"
+const accessExecuteKey = "execute"
+
+func resourceHandlers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHandlersCreate,
+		ReadContext:   resourceHandlersRead,
+		UpdateContext: resourceHandlersUpdate,
+		DeleteContext: resourceHandlersDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			accessReadKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			accessScriptKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			accessExecuteKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		}),
+	}
+}
+
+func resourceHandlersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating handlers: "+toJSON(scope))
+	handlers, err := client.ReadHandlersFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	handlers, err = client.UpdateHandlers(ctx, getHandlers(d, handlers.ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created handlers: "+toJSON(handlers))
+	d.SetId(handlers.ID)
+	return resourceHandlersRead(ctx, d, m)
+}
+
+func resourceHandlersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	handlers, err := client.ReadHandlers(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read handlers: "+toJSON(handlers))
+	if err = d.Set(accessReadKey, handlers.AccessPolicy.Read); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(accessScriptKey, handlers.AccessPolicy.Script); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(accessExecuteKey, handlers.AccessPolicy.Execute); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceHandlersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getHandlers(d, d.Id())
+	tflog.Debug(ctx, "Updating handlers: "+toJSON(request))
+	handlers, err := client.UpdateHandlers(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated handlers: "+toJSON(handlers))
+	return resourceHandlersRead(ctx, d, m)
+}
+
+func resourceHandlersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting handlers: "+toJSON(d.Id()))
+	_, err := client.UpdateHandlers(ctx, &iis.Handlers{
+		ID: d.Id(),
+		AccessPolicy: iis.AccessPolicy{
+			Read:   true,
+			Script: true,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted handlers: "+toJSON(d.Id()))
+	return nil
+}
+
+func getHandlers(d *schema.ResourceData, id string) *iis.Handlers {
+	return &iis.Handlers{
+		ID: id,
+		AccessPolicy: iis.AccessPolicy{
+			Read:    d.Get(accessReadKey).(bool),
+			Script:  d.Get(accessScriptKey).(bool),
+			Execute: d.Get(accessExecuteKey).(bool),
+		},
+	}
+}
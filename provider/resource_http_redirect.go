@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const destinationKey = "destination"
+const absoluteKey = "absolute"
+const preserveChildPathKey = "preserve_child_path"
+const statusCodeKey = "status_code"
+
+func resourceHttpRedirect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHttpRedirectCreate,
+		ReadContext:   resourceHttpRedirectRead,
+		UpdateContext: resourceHttpRedirectUpdate,
+		DeleteContext: resourceHttpRedirectDelete,
+		CustomizeDiff: validateHttpRedirectLoop,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			destinationKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			absoluteKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			preserveChildPathKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			statusCodeKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      302,
+				ValidateFunc: validation.IntInSlice([]int{301, 302, 307, 308}),
+			},
+		}),
+	}
+}
+
+func resourceHttpRedirectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating http redirect: "+toJSON(scope))
+	redirect, err := client.ReadHttpRedirectFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	redirect, err = client.UpdateHttpRedirect(ctx, getHttpRedirect(d, redirect.ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created http redirect: "+toJSON(redirect))
+	d.SetId(redirect.ID)
+	return resourceHttpRedirectRead(ctx, d, m)
+}
+
+func resourceHttpRedirectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	redirect, err := client.ReadHttpRedirect(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read http redirect: "+toJSON(redirect))
+	if err = d.Set("enabled", redirect.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(destinationKey, redirect.Destination); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(absoluteKey, redirect.Absolute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(preserveChildPathKey, redirect.PreserveFilename); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(statusCodeKey, redirect.StatusCode); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceHttpRedirectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getHttpRedirect(d, d.Id())
+	tflog.Debug(ctx, "Updating http redirect: "+toJSON(request))
+	redirect, err := client.UpdateHttpRedirect(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated http redirect: "+toJSON(redirect))
+	return resourceHttpRedirectRead(ctx, d, m)
+}
+
+func resourceHttpRedirectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting http redirect: "+toJSON(d.Id()))
+	redirect, err := client.ReadHttpRedirect(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	redirect.Enabled = false
+	if _, err := client.UpdateHttpRedirect(ctx, redirect); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted http redirect: "+toJSON(d.Id()))
+	return nil
+}
+
+func getHttpRedirect(d *schema.ResourceData, id string) *iis.HttpRedirect {
+	return &iis.HttpRedirect{
+		ID:               id,
+		Enabled:          d.Get("enabled").(bool),
+		Destination:      d.Get(destinationKey).(string),
+		Absolute:         d.Get(absoluteKey).(bool),
+		PreserveFilename: d.Get(preserveChildPathKey).(bool),
+		StatusCode:       d.Get(statusCodeKey).(int),
+	}
+}
+
+// validateHttpRedirectLoop rejects redirects whose destination is served by
+// the bindings of the redirecting site itself, as every response would be
+// redirected again.
+func validateHttpRedirectLoop(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.Get("enabled").(bool) {
+		return nil
+	}
+	if !d.NewValueKnown(destinationKey) || !d.NewValueKnown(WebsiteKey) || !d.NewValueKnown(ApplicationKey) {
+		return nil
+	}
+	destination, err := url.Parse(d.Get(destinationKey).(string))
+	if err != nil || destination.Host == "" {
+		return nil
+	}
+	client := m.(*providerMeta).client
+	websiteId := d.Get(WebsiteKey).(string)
+	path := "/"
+	if applicationId := d.Get(ApplicationKey).(string); applicationId != "" {
+		application, err := client.ReadApplication(ctx, applicationId)
+		if err != nil {
+			return err
+		}
+		websiteId = application.Website.ID
+		path = application.Path
+	}
+	site, err := client.ReadWebsite(ctx, websiteId)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(strings.ToLower(destination.Path+"/"), strings.ToLower(strings.TrimSuffix(path, "/")+"/")) {
+		return nil
+	}
+	for _, binding := range site.Bindings {
+		if bindingServesUrl(binding, destination) {
+			return fmt.Errorf("redirect to %s loops back to website %s through binding %s://%s:%d",
+				destination, site.Name, binding.Protocol, binding.Hostname, binding.Port)
+		}
+	}
+	return nil
+}
+
+func bindingServesUrl(binding iis.WebsiteBinding, destination *url.URL) bool {
+	if !strings.EqualFold(binding.Protocol, destination.Scheme) {
+		return false
+	}
+	port := destination.Port()
+	if port == "" {
+		port = "80"
+		if destination.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if port != strconv.Itoa(binding.Port) {
+		return false
+	}
+	host := destination.Hostname()
+	if binding.Hostname != "" {
+		return strings.EqualFold(binding.Hostname, host)
+	}
+	return strings.EqualFold(host, "localhost") || host == binding.IPAddress
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const allowUnlistedKey = "allow_unlisted"
+const enableReverseDnsKey = "enable_reverse_dns"
+const enableProxyModeKey = "enable_proxy_mode"
+const denyActionKey = "deny_action"
+const ipEntryKey = "entry"
+
+const ipEntryAddressKey = "ip_address"
+const ipEntrySubnetMaskKey = "subnet_mask"
+const ipEntryAllowedKey = "allowed"
+
+func resourceIpRestrictions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpRestrictionsCreate,
+		ReadContext:   resourceIpRestrictionsRead,
+		UpdateContext: resourceIpRestrictionsUpdate,
+		DeleteContext: resourceIpRestrictionsDelete,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			allowUnlistedKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			enableReverseDnsKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			enableProxyModeKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			denyActionKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Forbidden",
+				ValidateFunc: validation.StringInSlice([]string{"Abort", "Unauthorized", "Forbidden", "NotFound"}, false),
+			},
+			ipEntryKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      hashIpRestrictionEntry,
+				Elem:     ipRestrictionEntrySchema,
+			},
+		}),
+	}
+}
+
+var ipRestrictionEntrySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		ipEntryAddressKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "IP address or CIDR range, e.g. 10.0.0.0/8",
+			ValidateFunc: validation.Any(
+				validation.IsIPAddress,
+				validation.IsCIDR,
+			),
+		},
+		ipEntrySubnetMaskKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		ipEntryAllowedKey: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	},
+}
+
+func resourceIpRestrictionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating ip restrictions: "+toJSON(scope))
+	restrictions, err := client.ReadIpRestrictionsFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateIpRestrictions(ctx, d, client, restrictions); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created ip restrictions: "+toJSON(restrictions.ID))
+	d.SetId(restrictions.ID)
+	return resourceIpRestrictionsRead(ctx, d, m)
+}
+
+func resourceIpRestrictionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	restrictions, err := client.ReadIpRestrictions(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ip restrictions: "+toJSON(restrictions))
+	entries, err := client.ListIpRestrictionEntries(ctx, restrictions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entries = managedEntries(entries, ipRestrictionEntryKey, getIpRestrictionEntries(d.Get(ipEntryKey)))
+	if err = d.Set("enabled", restrictions.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(allowUnlistedKey, restrictions.AllowUnlisted); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(enableReverseDnsKey, restrictions.EnableReverseDns); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(enableProxyModeKey, restrictions.EnableProxyMode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(denyActionKey, restrictions.DenyAction); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(ipEntryKey, mapIpRestrictionEntriesToSet(d, entries)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceIpRestrictionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating ip restrictions: "+toJSON(d.Id()))
+	restrictions, err := client.ReadIpRestrictions(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateIpRestrictions(ctx, d, client, restrictions); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated ip restrictions: "+toJSON(d.Id()))
+	return resourceIpRestrictionsRead(ctx, d, m)
+}
+
+func resourceIpRestrictionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting ip restrictions: "+toJSON(d.Id()))
+	restrictions, err := client.ReadIpRestrictions(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncIpRestrictionEntries(ctx, client, restrictions, getIpRestrictionEntries(d.Get(ipEntryKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	restrictions.Enabled = false
+	restrictions.AllowUnlisted = true
+	if _, err := client.UpdateIpRestrictions(ctx, restrictions); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted ip restrictions: "+toJSON(d.Id()))
+	return nil
+}
+
+func updateIpRestrictions(ctx context.Context, d *schema.ResourceData, client *iis.Client, restrictions *iis.IpRestrictions) error {
+	restrictions.Enabled = d.Get("enabled").(bool)
+	restrictions.AllowUnlisted = d.Get(allowUnlistedKey).(bool)
+	restrictions.EnableReverseDns = d.Get(enableReverseDnsKey).(bool)
+	restrictions.EnableProxyMode = d.Get(enableProxyModeKey).(bool)
+	restrictions.DenyAction = d.Get(denyActionKey).(string)
+	if _, err := client.UpdateIpRestrictions(ctx, restrictions); err != nil {
+		return err
+	}
+	if !d.IsNewResource() && !d.HasChange(ipEntryKey) {
+		return nil
+	}
+	previous, desired := d.GetChange(ipEntryKey)
+	return syncIpRestrictionEntries(ctx, client, restrictions, getIpRestrictionEntries(previous), getIpRestrictionEntries(desired))
+}
+
+// syncIpRestrictionEntries replaces the previously managed entries by the
+// desired ones, leaving inherited and unmanaged entries in place.
+func syncIpRestrictionEntries(ctx context.Context, client *iis.Client, restrictions *iis.IpRestrictions, previous, desired []iis.IpRestrictionEntry) error {
+	existing, err := client.ListIpRestrictionEntries(ctx, restrictions)
+	if err != nil {
+		return err
+	}
+	existing = managedEntries(existing, ipRestrictionEntryKey, previous, desired)
+	return syncCollection(existing, desired, ipRestrictionEntryContent,
+		func(entry iis.IpRestrictionEntry) error {
+			return client.DeleteIpRestrictionEntry(ctx, entry.ID)
+		},
+		func(entry iis.IpRestrictionEntry) error {
+			_, err := client.CreateIpRestrictionEntry(ctx, restrictions, entry)
+			return err
+		})
+}
+
+// ipRestrictionEntryKey identifies an entry, while ipRestrictionEntryContent
+// also covers whether it is allowed, so changed entries get replaced.
+func ipRestrictionEntryKey(entry iis.IpRestrictionEntry) string {
+	address, mask := normalizeIpAddress(entry.IPAddress, entry.SubnetMask)
+	return address + "/" + mask
+}
+
+func ipRestrictionEntryContent(entry iis.IpRestrictionEntry) string {
+	return ipRestrictionEntryKey(entry) + "/" + strconv.FormatBool(entry.Allowed)
+}
+
+func getIpRestrictionEntries(v interface{}) []iis.IpRestrictionEntry {
+	var entries []iis.IpRestrictionEntry
+	for _, entry := range v.(*schema.Set).List() {
+		entries = append(entries, getIpRestrictionEntry(entry.(map[string]interface{})))
+	}
+	return entries
+}
+
+func getIpRestrictionEntry(entry map[string]interface{}) iis.IpRestrictionEntry {
+	address, mask := normalizeIpAddress(entry[ipEntryAddressKey].(string), entry[ipEntrySubnetMaskKey].(string))
+	return iis.IpRestrictionEntry{
+		IPAddress:  address,
+		SubnetMask: mask,
+		Allowed:    entry[ipEntryAllowedKey].(bool),
+	}
+}
+
+// normalizeIpAddress converts CIDR notation into the address and subnet mask
+// pair IIS stores, and fills in the host mask for plain addresses.
+func normalizeIpAddress(address, mask string) (string, string) {
+	if strings.Contains(address, "/") {
+		if ip, network, err := net.ParseCIDR(address); err == nil {
+			if ip.To4() != nil {
+				return network.IP.String(), net.IP(network.Mask).To4().String()
+			}
+			return network.IP.String(), net.IP(network.Mask).String()
+		}
+	}
+	if mask == "" {
+		if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+			return address, net.IP(net.CIDRMask(128, 128)).String()
+		}
+		return address, "255.255.255.255"
+	}
+	return address, mask
+}
+
+// mapIpRestrictionEntriesToSet keeps the CIDR notation of known entries, so
+// they don't show up as changed after IIS split them into address and mask.
+func mapIpRestrictionEntriesToSet(d *schema.ResourceData, entries []iis.IpRestrictionEntry) *schema.Set {
+	addresses := make(map[string]string)
+	for _, entry := range d.Get(ipEntryKey).(*schema.Set).List() {
+		entryMap := entry.(map[string]interface{})
+		addresses[ipRestrictionEntryKey(getIpRestrictionEntry(entryMap))] = entryMap[ipEntryAddressKey].(string)
+	}
+	var list []interface{}
+	for _, entry := range entries {
+		address, ok := addresses[ipRestrictionEntryKey(entry)]
+		if !ok {
+			address = entry.IPAddress
+		}
+		list = append(list, map[string]interface{}{
+			ipEntryAddressKey:    address,
+			ipEntrySubnetMaskKey: entry.SubnetMask,
+			ipEntryAllowedKey:    entry.Allowed,
+		})
+	}
+	return schema.NewSet(hashIpRestrictionEntry, list)
+}
+
+func hashIpRestrictionEntry(v interface{}) int {
+	entry := v.(map[string]interface{})
+	mask, _ := entry[ipEntrySubnetMaskKey].(string)
+	allowed, _ := entry[ipEntryAllowedKey].(bool)
+	address, mask := normalizeIpAddress(entry[ipEntryAddressKey].(string), mask)
+	return schema.HashString(fmt.Sprintf("%s/%s/%t", address, mask, allowed))
+}
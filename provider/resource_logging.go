@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const logPerSiteKey = "log_per_site"
+const logDirectoryKey = "directory"
+const logFormatKey = "format"
+const rolloverPeriodKey = "rollover_period"
+const rolloverSizeKey = "rollover_size"
+const rolloverLocalTimeKey = "rollover_use_local_time"
+const logFieldsKey = "fields"
+const customFieldKey = "custom_field"
+
+const customFieldNameKey = "field_name"
+const customFieldSourceNameKey = "source_name"
+const customFieldSourceTypeKey = "source_type"
+
+var w3cLogFields = []string{
+	"date", "time", "client_ip", "username", "site_name", "computer_name", "server_ip",
+	"method", "uri_stem", "uri_query", "http_status", "http_sub_status", "win32_status",
+	"bytes_sent", "bytes_recv", "time_taken", "server_port", "user_agent", "cookie",
+	"referer", "protocol_version", "host",
+}
+
+func resourceLogging() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoggingCreate,
+		ReadContext:   resourceLoggingRead,
+		UpdateContext: resourceLoggingUpdate,
+		DeleteContext: resourceLoggingDelete,
+
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Website to configure, the web server level is used when omitted",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			logPerSiteKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			logDirectoryKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%SystemDrive%\\inetpub\\logs\\LogFiles",
+			},
+			logFormatKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "w3c",
+				ValidateFunc: validation.StringInSlice([]string{"w3c", "iis", "ncsa", "custom", "binary"}, false),
+			},
+			rolloverPeriodKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "daily",
+				ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "weekly", "monthly", "maxsize"}, false),
+			},
+			rolloverSizeKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Log file size in bytes, used with the maxsize rollover period",
+			},
+			rolloverLocalTimeKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			logFieldsKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(w3cLogFields, false),
+				},
+			},
+			customFieldKey: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						customFieldNameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						customFieldSourceNameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						customFieldSourceTypeKey: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "request_header",
+							ValidateFunc: validation.StringInSlice([]string{"request_header", "response_header", "server_variable"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLoggingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := iis.FeatureScope{Website: d.Get(WebsiteKey).(string)}
+	tflog.Debug(ctx, "Creating logging: "+toJSON(scope))
+	logging, err := client.ReadLoggingFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	logging, err = client.UpdateLogging(ctx, getLogging(d, logging))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created logging: "+toJSON(logging))
+	d.SetId(logging.ID)
+	return resourceLoggingRead(ctx, d, m)
+}
+
+func resourceLoggingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	logging, err := client.ReadLogging(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read logging: "+toJSON(logging))
+	var fields []string
+	for field, enabled := range logging.LogFields {
+		if enabled {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	customFields := make([]interface{}, len(logging.CustomLogFields))
+	for i, field := range logging.CustomLogFields {
+		customFields[i] = map[string]interface{}{
+			customFieldNameKey:       field.FieldName,
+			customFieldSourceNameKey: field.SourceName,
+			customFieldSourceTypeKey: field.SourceType,
+		}
+	}
+	values := map[string]interface{}{
+		"enabled":            logging.Enabled,
+		logPerSiteKey:        logging.LogPerSite,
+		logDirectoryKey:      logging.Directory,
+		logFormatKey:         logging.LogFileFormat,
+		rolloverPeriodKey:    logging.Rollover.Period,
+		rolloverSizeKey:      logging.Rollover.TruncateSize,
+		rolloverLocalTimeKey: logging.Rollover.UseLocalTime,
+		logFieldsKey:         fields,
+		customFieldKey:       customFields,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceLoggingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	logging, err := client.ReadLogging(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getLogging(d, logging)
+	tflog.Debug(ctx, "Updating logging: "+toJSON(request))
+	logging, err = client.UpdateLogging(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated logging: "+toJSON(logging))
+	return resourceLoggingRead(ctx, d, m)
+}
+
+func resourceLoggingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+func getLogging(d *schema.ResourceData, current *iis.Logging) *iis.Logging {
+	logging := &iis.Logging{
+		ID:            current.ID,
+		Enabled:       d.Get("enabled").(bool),
+		LogPerSite:    d.Get(logPerSiteKey).(bool),
+		Directory:     d.Get(logDirectoryKey).(string),
+		LogFileFormat: d.Get(logFormatKey).(string),
+		Rollover: iis.LogRollover{
+			Period:       d.Get(rolloverPeriodKey).(string),
+			TruncateSize: current.Rollover.TruncateSize,
+			UseLocalTime: d.Get(rolloverLocalTimeKey).(bool),
+		},
+		LogFields:       current.LogFields,
+		CustomLogFields: []iis.CustomLogField{},
+	}
+	if isConfigured(d, rolloverSizeKey) {
+		logging.Rollover.TruncateSize = int64(d.Get(rolloverSizeKey).(int))
+	}
+	if isConfigured(d, logFieldsKey) {
+		selected := d.Get(logFieldsKey).(*schema.Set)
+		logging.LogFields = make(map[string]bool, len(w3cLogFields))
+		for _, field := range w3cLogFields {
+			logging.LogFields[field] = selected.Contains(field)
+		}
+	}
+	for _, entry := range getList(d, customFieldKey) {
+		field := entry.(map[string]interface{})
+		logging.CustomLogFields = append(logging.CustomLogFields, iis.CustomLogField{
+			FieldName:  field[customFieldNameKey].(string),
+			SourceName: field[customFieldSourceNameKey].(string),
+			SourceType: field[customFieldSourceTypeKey].(string),
+		})
+	}
+	return logging
+}
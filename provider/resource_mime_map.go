@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceMimeMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMimeMapCreate,
+		ReadContext:   resourceMimeMapRead,
+		UpdateContext: resourceMimeMapUpdate,
+		DeleteContext: resourceMimeMapDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			fileExtensionMimeKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			mimeTypeKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		}),
+	}
+}
+
+func resourceMimeMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	content, err := client.ReadStaticContentFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := iis.MimeMap{
+		FileExtension: d.Get(fileExtensionMimeKey).(string),
+		MimeType:      d.Get(mimeTypeKey).(string),
+	}
+	tflog.Debug(ctx, "Creating mime map: "+toJSON(request))
+	mimeMap, err := client.CreateMimeMap(ctx, content, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created mime map: "+toJSON(mimeMap))
+	d.SetId(mimeMap.ID)
+	return resourceMimeMapRead(ctx, d, m)
+}
+
+func resourceMimeMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	mimeMap, err := client.ReadMimeMap(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read mime map: "+toJSON(mimeMap))
+	if err = d.Set(fileExtensionMimeKey, mimeMap.FileExtension); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(mimeTypeKey, mimeMap.MimeType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceMimeMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := &iis.MimeMap{
+		ID:            d.Id(),
+		FileExtension: d.Get(fileExtensionMimeKey).(string),
+		MimeType:      d.Get(mimeTypeKey).(string),
+	}
+	tflog.Debug(ctx, "Updating mime map: "+toJSON(request))
+	mimeMap, err := client.UpdateMimeMap(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated mime map: "+toJSON(mimeMap))
+	d.SetId(mimeMap.ID)
+	return resourceMimeMapRead(ctx, d, m)
+}
+
+func resourceMimeMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting mime map: "+toJSON(id))
+	if err := client.DeleteMimeMap(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted mime map: "+toJSON(id))
+	return nil
+}
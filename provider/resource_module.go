@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const moduleTypeKey = "type"
+
+func resourceModule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceModuleCreate,
+		ReadContext:   resourceModuleRead,
+		DeleteContext: resourceModuleDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			nameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			moduleTypeKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Type of a managed module, left empty to enable a global native module",
+			},
+			preconditionKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		}),
+	}
+}
+
+func resourceModuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	modules, err := client.ReadModulesFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := iis.ModuleEntry{
+		Name:         d.Get(nameKey).(string),
+		Type:         d.Get(moduleTypeKey).(string),
+		Precondition: d.Get(preconditionKey).(string),
+	}
+	tflog.Debug(ctx, "Creating module: "+toJSON(request))
+	entry, err := client.CreateModuleEntry(ctx, modules, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created module: "+toJSON(entry))
+	d.SetId(entry.ID)
+	return resourceModuleRead(ctx, d, m)
+}
+
+func resourceModuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	entry, err := client.ReadModuleEntry(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read module: "+toJSON(entry))
+	if err = d.Set(nameKey, entry.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(moduleTypeKey, entry.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(preconditionKey, entry.Precondition); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceModuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting module: "+toJSON(id))
+	if err := client.DeleteModuleEntry(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted module: "+toJSON(id))
+	return nil
+}
@@ -0,0 +1,537 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const maxContentLengthKey = "max_content_length"
+const maxUrlLengthKey = "max_url_length"
+const maxQueryStringLengthKey = "max_query_string_length"
+const allowDoubleEscapingKey = "allow_double_escaping"
+const allowHighBitCharactersKey = "allow_high_bit_characters"
+
+const fileExtensionKey = "file_extension"
+const hiddenSegmentsKey = "hidden_segments"
+const deniedUrlSequencesKey = "denied_url_sequences"
+const queryStringKey = "query_string"
+const headerLimitKey = "header_limit"
+const filteringRuleKey = "filtering_rule"
+
+const extensionKey = "extension"
+const allowedKey = "allowed"
+const headerKey = "header"
+const sizeLimitKey = "size_limit"
+const scanUrlKey = "scan_url"
+const scanQueryStringKey = "scan_query_string"
+const scanHeadersKey = "scan_headers"
+const appliesToKey = "applies_to"
+const denyStringsKey = "deny_strings"
+
+func resourceRequestFiltering() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRequestFilteringCreate,
+		ReadContext:   resourceRequestFilteringRead,
+		UpdateContext: resourceRequestFilteringUpdate,
+		DeleteContext: resourceRequestFilteringDelete,
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			maxContentLengthKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30000000,
+			},
+			maxUrlLengthKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4096,
+			},
+			maxQueryStringLengthKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2048,
+			},
+			allowDoubleEscapingKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			allowHighBitCharactersKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			fileExtensionKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						extensionKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						allowedKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			hiddenSegmentsKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			deniedUrlSequencesKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			queryStringKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						queryStringKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						allowedKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			headerLimitKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						headerKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						sizeLimitKey: {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			filteringRuleKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						nameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						scanUrlKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						scanQueryStringKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						scanHeadersKey: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						appliesToKey: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						denyStringsKey: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceRequestFilteringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating request filtering: "+toJSON(scope))
+	filtering, err := client.ReadRequestFilteringFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateRequestFiltering(ctx, d, client, filtering); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created request filtering: "+toJSON(filtering.ID))
+	d.SetId(filtering.ID)
+	return resourceRequestFilteringRead(ctx, d, m)
+}
+
+func resourceRequestFilteringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	filtering, err := client.ReadRequestFiltering(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request filtering: "+toJSON(filtering))
+	values := map[string]interface{}{
+		maxContentLengthKey:       filtering.MaxContentLength,
+		maxUrlLengthKey:           filtering.MaxUrlLength,
+		maxQueryStringLengthKey:   filtering.MaxQueryStringLength,
+		allowDoubleEscapingKey:    filtering.AllowDoubleEscaping,
+		allowHighBitCharactersKey: filtering.AllowHighBitCharacters,
+	}
+
+	existing, err := listRequestFilteringCollections(ctx, client, filtering)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	collections := existing.managed(getRequestFilteringCollections(d.Get))
+
+	extensionList := make([]interface{}, len(collections.extensions))
+	for i, extension := range collections.extensions {
+		extensionList[i] = map[string]interface{}{
+			extensionKey: extension.Extension,
+			allowedKey:   extension.Allow,
+		}
+	}
+	values[fileExtensionKey] = extensionList
+
+	segmentList := make([]string, len(collections.segments))
+	for i, segment := range collections.segments {
+		segmentList[i] = segment.Segment
+	}
+	values[hiddenSegmentsKey] = segmentList
+
+	urlList := make([]string, len(collections.urls))
+	for i, url := range collections.urls {
+		urlList[i] = url.Url
+	}
+	values[deniedUrlSequencesKey] = urlList
+
+	queryStringList := make([]interface{}, len(collections.queryStrings))
+	for i, queryString := range collections.queryStrings {
+		queryStringList[i] = map[string]interface{}{
+			queryStringKey: queryString.QueryString,
+			allowedKey:     queryString.Allow,
+		}
+	}
+	values[queryStringKey] = queryStringList
+
+	headerLimitList := make([]interface{}, len(collections.headerLimits))
+	for i, limit := range collections.headerLimits {
+		headerLimitList[i] = map[string]interface{}{
+			headerKey:    limit.Header,
+			sizeLimitKey: limit.SizeLimit,
+		}
+	}
+	values[headerLimitKey] = headerLimitList
+
+	ruleList := make([]interface{}, len(collections.rules))
+	for i, rule := range collections.rules {
+		ruleList[i] = map[string]interface{}{
+			nameKey:            rule.Name,
+			scanUrlKey:         rule.ScanUrl,
+			scanQueryStringKey: rule.ScanQueryString,
+			scanHeadersKey:     rule.Headers,
+			appliesToKey:       rule.AppliesTo,
+			denyStringsKey:     rule.DenyStrings,
+		}
+	}
+	values[filteringRuleKey] = ruleList
+
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceRequestFilteringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating request filtering: "+toJSON(d.Id()))
+	filtering, err := client.ReadRequestFiltering(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateRequestFiltering(ctx, d, client, filtering); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated request filtering: "+toJSON(d.Id()))
+	return resourceRequestFilteringRead(ctx, d, m)
+}
+
+func resourceRequestFilteringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting request filtering: "+toJSON(d.Id()))
+	filtering, err := client.ReadRequestFiltering(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managed := getRequestFilteringCollections(d.Get)
+	if err := syncRequestFilteringCollections(ctx, client, filtering, managed, requestFilteringCollections{}); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted request filtering: "+toJSON(d.Id()))
+	return nil
+}
+
+type requestFilteringCollections struct {
+	extensions   []iis.FileNameExtension
+	segments     []iis.HiddenSegment
+	urls         []iis.UrlSequence
+	queryStrings []iis.QueryStringSequence
+	headerLimits []iis.HeaderLimit
+	rules        []iis.RequestFilteringRule
+}
+
+func updateRequestFiltering(ctx context.Context, d *schema.ResourceData, client *iis.Client, filtering *iis.RequestFiltering) error {
+	filtering.MaxContentLength = int64(d.Get(maxContentLengthKey).(int))
+	filtering.MaxUrlLength = int64(d.Get(maxUrlLengthKey).(int))
+	filtering.MaxQueryStringLength = int64(d.Get(maxQueryStringLengthKey).(int))
+	filtering.AllowDoubleEscaping = d.Get(allowDoubleEscapingKey).(bool)
+	filtering.AllowHighBitCharacters = d.Get(allowHighBitCharactersKey).(bool)
+	if _, err := client.UpdateRequestFiltering(ctx, filtering); err != nil {
+		return err
+	}
+	previous := getRequestFilteringCollections(func(key string) interface{} {
+		old, _ := d.GetChange(key)
+		return old
+	})
+	return syncRequestFilteringCollections(ctx, client, filtering, previous, getRequestFilteringCollections(d.Get))
+}
+
+func getRequestFilteringCollections(get func(string) interface{}) requestFilteringCollections {
+	var collections requestFilteringCollections
+	for _, entry := range get(fileExtensionKey).(*schema.Set).List() {
+		extension := entry.(map[string]interface{})
+		collections.extensions = append(collections.extensions, iis.FileNameExtension{
+			Extension: extension[extensionKey].(string),
+			Allow:     extension[allowedKey].(bool),
+		})
+	}
+	for _, segment := range get(hiddenSegmentsKey).(*schema.Set).List() {
+		collections.segments = append(collections.segments, iis.HiddenSegment{Segment: segment.(string)})
+	}
+	for _, url := range get(deniedUrlSequencesKey).(*schema.Set).List() {
+		collections.urls = append(collections.urls, iis.UrlSequence{Url: url.(string)})
+	}
+	for _, entry := range get(queryStringKey).(*schema.Set).List() {
+		queryString := entry.(map[string]interface{})
+		collections.queryStrings = append(collections.queryStrings, iis.QueryStringSequence{
+			QueryString: queryString[queryStringKey].(string),
+			Allow:       queryString[allowedKey].(bool),
+		})
+	}
+	for _, entry := range get(headerLimitKey).(*schema.Set).List() {
+		limit := entry.(map[string]interface{})
+		collections.headerLimits = append(collections.headerLimits, iis.HeaderLimit{
+			Header:    limit[headerKey].(string),
+			SizeLimit: int64(limit[sizeLimitKey].(int)),
+		})
+	}
+	for _, entry := range get(filteringRuleKey).(*schema.Set).List() {
+		rule := entry.(map[string]interface{})
+		collections.rules = append(collections.rules, iis.RequestFilteringRule{
+			Name:            rule[nameKey].(string),
+			ScanUrl:         rule[scanUrlKey].(bool),
+			ScanQueryString: rule[scanQueryStringKey].(bool),
+			Headers:         toStringList(rule[scanHeadersKey].([]interface{})),
+			AppliesTo:       toStringList(rule[appliesToKey].([]interface{})),
+			DenyStrings:     toStringList(rule[denyStringsKey].([]interface{})),
+		})
+	}
+	return collections
+}
+
+func listRequestFilteringCollections(ctx context.Context, client *iis.Client, filtering *iis.RequestFiltering) (requestFilteringCollections, error) {
+	var collections requestFilteringCollections
+	var err error
+	if collections.extensions, err = client.ListFileNameExtensions(ctx, filtering); err != nil {
+		return collections, err
+	}
+	if collections.segments, err = client.ListHiddenSegments(ctx, filtering); err != nil {
+		return collections, err
+	}
+	urls, err := client.ListUrlSequences(ctx, filtering)
+	if err != nil {
+		return collections, err
+	}
+	for _, url := range urls {
+		if !url.Allow {
+			collections.urls = append(collections.urls, url)
+		}
+	}
+	if collections.queryStrings, err = client.ListQueryStringSequences(ctx, filtering); err != nil {
+		return collections, err
+	}
+	if collections.headerLimits, err = client.ListHeaderLimits(ctx, filtering); err != nil {
+		return collections, err
+	}
+	if collections.rules, err = client.ListRequestFilteringRules(ctx, filtering); err != nil {
+		return collections, err
+	}
+	return collections, nil
+}
+
+// managed reduces the collections to the entries tracked by the given lists,
+// as request filtering inherits a lot of defaults which must stay in place.
+func (c requestFilteringCollections) managed(lists ...requestFilteringCollections) requestFilteringCollections {
+	var extensions [][]iis.FileNameExtension
+	var segments [][]iis.HiddenSegment
+	var urls [][]iis.UrlSequence
+	var queryStrings [][]iis.QueryStringSequence
+	var headerLimits [][]iis.HeaderLimit
+	var rules [][]iis.RequestFilteringRule
+	for _, list := range lists {
+		extensions = append(extensions, list.extensions)
+		segments = append(segments, list.segments)
+		urls = append(urls, list.urls)
+		queryStrings = append(queryStrings, list.queryStrings)
+		headerLimits = append(headerLimits, list.headerLimits)
+		rules = append(rules, list.rules)
+	}
+	return requestFilteringCollections{
+		extensions:   managedEntries(c.extensions, fileNameExtensionKey, extensions...),
+		segments:     managedEntries(c.segments, hiddenSegmentKey, segments...),
+		urls:         managedEntries(c.urls, urlSequenceKey, urls...),
+		queryStrings: managedEntries(c.queryStrings, queryStringSequenceKey, queryStrings...),
+		headerLimits: managedEntries(c.headerLimits, headerLimitEntryKey, headerLimits...),
+		rules:        managedEntries(c.rules, requestFilteringRuleKey, rules...),
+	}
+}
+
+func syncRequestFilteringCollections(ctx context.Context, client *iis.Client, filtering *iis.RequestFiltering, previous, desired requestFilteringCollections) error {
+	all, err := listRequestFilteringCollections(ctx, client, filtering)
+	if err != nil {
+		return err
+	}
+	existing := all.managed(previous, desired)
+
+	err = syncCollection(existing.extensions, desired.extensions, fileNameExtensionContent,
+		func(extension iis.FileNameExtension) error {
+			return client.DeleteFileNameExtension(ctx, extension.ID)
+		},
+		func(extension iis.FileNameExtension) error {
+			_, err := client.CreateFileNameExtension(ctx, filtering, extension)
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	err = syncCollection(existing.segments, desired.segments, hiddenSegmentKey,
+		func(segment iis.HiddenSegment) error {
+			return client.DeleteHiddenSegment(ctx, segment.ID)
+		},
+		func(segment iis.HiddenSegment) error {
+			_, err := client.CreateHiddenSegment(ctx, filtering, segment)
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	err = syncCollection(existing.urls, desired.urls, urlSequenceKey,
+		func(url iis.UrlSequence) error {
+			return client.DeleteUrlSequence(ctx, url.ID)
+		},
+		func(url iis.UrlSequence) error {
+			_, err := client.CreateUrlSequence(ctx, filtering, url)
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	err = syncCollection(existing.queryStrings, desired.queryStrings, queryStringSequenceContent,
+		func(queryString iis.QueryStringSequence) error {
+			return client.DeleteQueryStringSequence(ctx, queryString.ID)
+		},
+		func(queryString iis.QueryStringSequence) error {
+			_, err := client.CreateQueryStringSequence(ctx, filtering, queryString)
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	err = syncCollection(existing.headerLimits, desired.headerLimits, headerLimitContent,
+		func(limit iis.HeaderLimit) error {
+			return client.DeleteHeaderLimit(ctx, limit.ID)
+		},
+		func(limit iis.HeaderLimit) error {
+			_, err := client.CreateHeaderLimit(ctx, filtering, limit)
+			return err
+		})
+	if err != nil {
+		return err
+	}
+	return syncCollection(existing.rules, desired.rules, requestFilteringRuleContent,
+		func(rule iis.RequestFilteringRule) error {
+			return client.DeleteRequestFilteringRule(ctx, rule.ID)
+		},
+		func(rule iis.RequestFilteringRule) error {
+			_, err := client.CreateRequestFilteringRule(ctx, filtering, rule)
+			return err
+		})
+}
+
+// The *Key functions identify an entry, while the *Content functions also
+// cover its settings, so changed entries get replaced.
+
+func fileNameExtensionKey(extension iis.FileNameExtension) string {
+	return strings.ToLower(extension.Extension)
+}
+
+func hiddenSegmentKey(segment iis.HiddenSegment) string {
+	return strings.ToLower(segment.Segment)
+}
+
+func urlSequenceKey(url iis.UrlSequence) string {
+	return url.Url
+}
+
+func queryStringSequenceKey(queryString iis.QueryStringSequence) string {
+	return queryString.QueryString
+}
+
+func headerLimitEntryKey(limit iis.HeaderLimit) string {
+	return strings.ToLower(limit.Header)
+}
+
+func requestFilteringRuleKey(rule iis.RequestFilteringRule) string {
+	return rule.Name
+}
+
+func fileNameExtensionContent(extension iis.FileNameExtension) string {
+	return fmt.Sprintf("%s/%t", fileNameExtensionKey(extension), extension.Allow)
+}
+
+func queryStringSequenceContent(queryString iis.QueryStringSequence) string {
+	return fmt.Sprintf("%s/%t", queryStringSequenceKey(queryString), queryString.Allow)
+}
+
+func headerLimitContent(limit iis.HeaderLimit) string {
+	return fmt.Sprintf("%s/%d", headerLimitEntryKey(limit), limit.SizeLimit)
+}
+
+func requestFilteringRuleContent(rule iis.RequestFilteringRule) string {
+	return fmt.Sprintf("%s/%t/%t/%s/%s/%s", requestFilteringRuleKey(rule), rule.ScanUrl, rule.ScanQueryString,
+		strings.Join(rule.Headers, ","), strings.Join(rule.AppliesTo, ","), strings.Join(rule.DenyStrings, ","))
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const tracingDirectoryKey = "directory"
+const maxLogFilesKey = "max_log_files"
+const traceRuleKey = "rule"
+
+const traceRulePathKey = "path"
+const statusCodesKey = "status_codes"
+const timeTakenKey = "time_taken"
+const eventSeverityKey = "event_severity"
+const traceKey = "trace"
+
+const traceProviderKey = "provider"
+const traceAreasKey = "areas"
+const traceVerbosityKey = "verbosity"
+
+func resourceRequestTracing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRequestTracingCreate,
+		ReadContext:   resourceRequestTracingRead,
+		UpdateContext: resourceRequestTracingUpdate,
+		DeleteContext: resourceRequestTracingDelete,
+
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			tracingDirectoryKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%SystemDrive%\\inetpub\\logs\\FailedReqLogFiles",
+			},
+			maxLogFilesKey: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  50,
+			},
+			traceRuleKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     traceRuleSchema,
+			},
+		},
+	}
+}
+
+var traceRuleSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		traceRulePathKey: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "*",
+		},
+		statusCodesKey: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Status codes or ranges to trace, e.g. 500 or 400-499",
+		},
+		timeTakenKey: {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+			Description: "Minimum request execution time in milliseconds, 0 disables the condition",
+		},
+		eventSeverityKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "ignore",
+			ValidateFunc: validation.StringInSlice([]string{"ignore", "warning", "error", "criticalerror"}, false),
+		},
+		traceKey: {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					traceProviderKey: {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the trace provider, e.g. WWW Server or ASPNET",
+					},
+					traceAreasKey: {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					traceVerbosityKey: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "verbose",
+						ValidateFunc: validation.StringInSlice([]string{"general", "criticalerror", "error", "warning", "information", "verbose"}, false),
+					},
+				},
+			},
+		},
+	},
+}
+
+func resourceRequestTracingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := iis.FeatureScope{Website: d.Get(WebsiteKey).(string)}
+	tflog.Debug(ctx, "Creating request tracing: "+toJSON(scope))
+	tracing, err := client.ReadRequestTracingFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateRequestTracing(ctx, d, client, tracing); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created request tracing: "+toJSON(tracing.ID))
+	d.SetId(tracing.ID)
+	return resourceRequestTracingRead(ctx, d, m)
+}
+
+func resourceRequestTracingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tracing, err := client.ReadRequestTracing(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request tracing: "+toJSON(tracing))
+	rules, err := client.ListTraceRules(ctx, tracing)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rules = managedEntries(rules, traceRuleIdentity, traceRulePaths(d.Get(traceRuleKey)))
+	values := map[string]interface{}{
+		"enabled":           tracing.Enabled,
+		tracingDirectoryKey: tracing.Directory,
+		maxLogFilesKey:      tracing.MaximumNumberTraceFiles,
+		traceRuleKey:        mapTraceRulesToList(rules, configuredTraceAreas(d)),
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceRequestTracingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating request tracing: "+toJSON(d.Id()))
+	tracing, err := client.ReadRequestTracing(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateRequestTracing(ctx, d, client, tracing); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated request tracing: "+toJSON(d.Id()))
+	return resourceRequestTracingRead(ctx, d, m)
+}
+
+func resourceRequestTracingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting request tracing: "+toJSON(d.Id()))
+	tracing, err := client.ReadRequestTracing(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncTraceRules(ctx, client, tracing, traceRulePaths(d.Get(traceRuleKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	tracing.Enabled = false
+	if _, err := client.UpdateRequestTracing(ctx, tracing); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted request tracing: "+toJSON(d.Id()))
+	return nil
+}
+
+func updateRequestTracing(ctx context.Context, d *schema.ResourceData, client *iis.Client, tracing *iis.RequestTracing) error {
+	tracing.Enabled = d.Get("enabled").(bool)
+	tracing.Directory = d.Get(tracingDirectoryKey).(string)
+	tracing.MaximumNumberTraceFiles = int64(d.Get(maxLogFilesKey).(int))
+	if _, err := client.UpdateRequestTracing(ctx, tracing); err != nil {
+		return err
+	}
+	if !d.IsNewResource() && !d.HasChange(traceRuleKey) {
+		return nil
+	}
+	rules, err := getTraceRules(ctx, d, client, tracing)
+	if err != nil {
+		return err
+	}
+	previous, _ := d.GetChange(traceRuleKey)
+	return syncTraceRules(ctx, client, tracing, traceRulePaths(previous), rules)
+}
+
+// syncTraceRules replaces the previously managed rules by the desired ones,
+// leaving rules of other paths in place.
+func syncTraceRules(ctx context.Context, client *iis.Client, tracing *iis.RequestTracing, previous, rules []iis.TraceRule) error {
+	existing, err := client.ListTraceRules(ctx, tracing)
+	if err != nil {
+		return err
+	}
+	existing = managedEntries(existing, traceRuleIdentity, previous, rules)
+	return syncCollection(existing, rules, traceRuleContent,
+		func(rule iis.TraceRule) error {
+			return client.DeleteTraceRule(ctx, rule.ID)
+		},
+		func(rule iis.TraceRule) error {
+			_, err := client.CreateTraceRule(ctx, tracing, rule)
+			return err
+		})
+}
+
+// getTraceRules resolves the configured provider names against the trace
+// providers known to IIS, as rules reference providers by id.
+func getTraceRules(ctx context.Context, d *schema.ResourceData, client *iis.Client, tracing *iis.RequestTracing) ([]iis.TraceRule, error) {
+	providers, err := client.ListTraceProviders(ctx, tracing)
+	if err != nil {
+		return nil, err
+	}
+	var rules []iis.TraceRule
+	for _, entry := range d.Get(traceRuleKey).(*schema.Set).List() {
+		rule := entry.(map[string]interface{})
+		var traces []iis.TraceEntry
+		for _, traceEntry := range rule[traceKey].([]interface{}) {
+			trace := traceEntry.(map[string]interface{})
+			name := trace[traceProviderKey].(string)
+			provider, err := findTraceProvider(providers, name)
+			if err != nil {
+				return nil, err
+			}
+			areas := make(map[string]bool, len(provider.Areas))
+			selected := trace[traceAreasKey].(*schema.Set)
+			for _, area := range provider.Areas {
+				areas[area] = selected.Len() == 0 || selected.Contains(area)
+			}
+			traces = append(traces, iis.TraceEntry{
+				AllowedAreas: areas,
+				Provider:     iis.ProviderReference{ID: provider.ID, Name: provider.Name},
+				Verbosity:    trace[traceVerbosityKey].(string),
+			})
+		}
+		rules = append(rules, iis.TraceRule{
+			Path:                    rule[traceRulePathKey].(string),
+			StatusCodes:             toStringList(rule[statusCodesKey].([]interface{})),
+			MinRequestExecutionTime: int64(rule[timeTakenKey].(int)),
+			EventSeverity:           rule[eventSeverityKey].(string),
+			Traces:                  traces,
+		})
+	}
+	return rules, nil
+}
+
+func findTraceProvider(providers []iis.TraceProvider, name string) (*iis.TraceProvider, error) {
+	for i, provider := range providers {
+		if strings.EqualFold(provider.Name, name) {
+			return &providers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown trace provider %q", name)
+}
+
+// configuredTraceAreas collects the configured areas by rule path and provider,
+// so that a trace listing every area of its provider reads back as configured.
+func configuredTraceAreas(d *schema.ResourceData) map[string][]string {
+	configured := make(map[string][]string)
+	for _, entry := range d.Get(traceRuleKey).(*schema.Set).List() {
+		rule := entry.(map[string]interface{})
+		for _, traceEntry := range rule[traceKey].([]interface{}) {
+			trace := traceEntry.(map[string]interface{})
+			key := traceAreasLookupKey(rule[traceRulePathKey].(string), trace[traceProviderKey].(string))
+			configured[key] = toStringList(trace[traceAreasKey].(*schema.Set).List())
+		}
+	}
+	return configured
+}
+
+func traceAreasLookupKey(path string, provider string) string {
+	return strings.ToLower(path + "|" + provider)
+}
+
+func mapTraceRulesToList(rules []iis.TraceRule, configured map[string][]string) []interface{} {
+	list := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		traces := make([]interface{}, len(rule.Traces))
+		for j, trace := range rule.Traces {
+			areas := allowedTraceAreas(trace)
+			if listed := configured[traceAreasLookupKey(rule.Path, trace.Provider.Name)]; areas == nil && len(listed) == len(trace.AllowedAreas) {
+				areas = listed
+			}
+			traces[j] = map[string]interface{}{
+				traceProviderKey:  trace.Provider.Name,
+				traceAreasKey:     areas,
+				traceVerbosityKey: trace.Verbosity,
+			}
+		}
+		list[i] = map[string]interface{}{
+			traceRulePathKey: rule.Path,
+			statusCodesKey:   rule.StatusCodes,
+			timeTakenKey:     rule.MinRequestExecutionTime,
+			eventSeverityKey: rule.EventSeverity,
+			traceKey:         traces,
+		}
+	}
+	return list
+}
+
+// allowedTraceAreas reports the enabled areas of a trace, where all areas
+// being enabled is represented by an empty list.
+func allowedTraceAreas(trace iis.TraceEntry) []string {
+	var areas []string
+	for area, allowed := range trace.AllowedAreas {
+		if allowed {
+			areas = append(areas, area)
+		}
+	}
+	if len(areas) == len(trace.AllowedAreas) {
+		return nil
+	}
+	sort.Strings(areas)
+	return areas
+}
+
+// traceRuleIdentity identifies a rule by its path, which IIS allows only one
+// rule per, while traceRuleContent also covers its settings.
+func traceRuleIdentity(rule iis.TraceRule) string {
+	return strings.ToLower(rule.Path)
+}
+
+// traceRulePaths returns rules holding just the paths of the configured ones,
+// which is all managedEntries needs to tell the managed rules apart.
+func traceRulePaths(v interface{}) []iis.TraceRule {
+	var rules []iis.TraceRule
+	for _, entry := range v.(*schema.Set).List() {
+		rules = append(rules, iis.TraceRule{Path: entry.(map[string]interface{})[traceRulePathKey].(string)})
+	}
+	return rules
+}
+
+func traceRuleContent(rule iis.TraceRule) string {
+	traces := make([]string, len(rule.Traces))
+	for i, trace := range rule.Traces {
+		traces[i] = fmt.Sprintf("%s:%s:%s", strings.ToLower(trace.Provider.Name), strings.Join(allowedTraceAreas(trace), ","), trace.Verbosity)
+	}
+	return fmt.Sprintf("%s/%s/%d/%s/%s", rule.Path, strings.Join(rule.StatusCodes, ","),
+		rule.MinRequestExecutionTime, rule.EventSeverity, strings.Join(traces, ";"))
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const doStaticCompressionKey = "do_static_compression"
+const doDynamicCompressionKey = "do_dynamic_compression"
+const compressionDirectoryKey = "directory"
+const doDiskSpaceLimitingKey = "do_disk_space_limiting"
+const maxDiskSpaceUsageKey = "max_disk_space_usage"
+const minFileSizeForCompressionKey = "min_file_size_for_compression"
+const staticCompressionEnableCpuUsageKey = "static_compression_enable_cpu_usage"
+const staticCompressionDisableCpuUsageKey = "static_compression_disable_cpu_usage"
+const dynamicCompressionEnableCpuUsageKey = "dynamic_compression_enable_cpu_usage"
+const dynamicCompressionDisableCpuUsageKey = "dynamic_compression_disable_cpu_usage"
+
+var serverCompressionKeys = []string{
+	compressionDirectoryKey,
+	doDiskSpaceLimitingKey,
+	maxDiskSpaceUsageKey,
+	minFileSizeForCompressionKey,
+	staticCompressionEnableCpuUsageKey,
+	staticCompressionDisableCpuUsageKey,
+	dynamicCompressionEnableCpuUsageKey,
+	dynamicCompressionDisableCpuUsageKey,
+}
+
+func resourceResponseCompression() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResponseCompressionCreate,
+		ReadContext:   resourceResponseCompressionRead,
+		UpdateContext: resourceResponseCompressionUpdate,
+		DeleteContext: resourceResponseCompressionDelete,
+		CustomizeDiff: validateResponseCompressionScope,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			doStaticCompressionKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			doDynamicCompressionKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			compressionDirectoryKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			doDiskSpaceLimitingKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			maxDiskSpaceUsageKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Disk space in MB used for compressed files per application pool",
+			},
+			minFileSizeForCompressionKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum file size in bytes for static compression",
+			},
+			staticCompressionEnableCpuUsageKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			staticCompressionDisableCpuUsageKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			dynamicCompressionEnableCpuUsageKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			dynamicCompressionDisableCpuUsageKey: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		}),
+	}
+}
+
+func resourceResponseCompressionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating response compression: "+toJSON(scope))
+	compression, err := client.ReadResponseCompressionFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	compression, err = client.UpdateResponseCompression(ctx, compression.ID, getResponseCompressionUpdate(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created response compression: "+toJSON(compression))
+	d.SetId(compression.ID)
+	return resourceResponseCompressionRead(ctx, d, m)
+}
+
+func resourceResponseCompressionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	compression, err := client.ReadResponseCompression(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read response compression: "+toJSON(compression))
+	values := map[string]interface{}{
+		doStaticCompressionKey:               compression.DoStaticCompression,
+		doDynamicCompressionKey:              compression.DoDynamicCompression,
+		compressionDirectoryKey:              compression.Directory,
+		doDiskSpaceLimitingKey:               compression.DoDiskSpaceLimiting,
+		maxDiskSpaceUsageKey:                 compression.MaxDiskSpaceUsage,
+		minFileSizeForCompressionKey:         compression.MinFileSizeForCompression,
+		staticCompressionEnableCpuUsageKey:   compression.StaticCompressionEnableCpuUsage,
+		staticCompressionDisableCpuUsageKey:  compression.StaticCompressionDisableCpuUsage,
+		dynamicCompressionEnableCpuUsageKey:  compression.DynamicCompressionEnableCpuUsage,
+		dynamicCompressionDisableCpuUsageKey: compression.DynamicCompressionDisableCpuUsage,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceResponseCompressionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getResponseCompressionUpdate(d)
+	tflog.Debug(ctx, "Updating response compression: "+toJSON(request))
+	compression, err := client.UpdateResponseCompression(ctx, d.Id(), request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated response compression: "+toJSON(compression))
+	return resourceResponseCompressionRead(ctx, d, m)
+}
+
+func resourceResponseCompressionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+func getResponseCompressionUpdate(d *schema.ResourceData) iis.ResponseCompressionUpdate {
+	doStatic := d.Get(doStaticCompressionKey).(bool)
+	doDynamic := d.Get(doDynamicCompressionKey).(bool)
+	update := iis.ResponseCompressionUpdate{
+		DoStaticCompression:  &doStatic,
+		DoDynamicCompression: &doDynamic,
+	}
+	if getFeatureScope(d) != (iis.FeatureScope{}) {
+		return update
+	}
+	if isConfigured(d, compressionDirectoryKey) {
+		directory := d.Get(compressionDirectoryKey).(string)
+		update.Directory = &directory
+	}
+	if isConfigured(d, doDiskSpaceLimitingKey) {
+		limiting := d.Get(doDiskSpaceLimitingKey).(bool)
+		update.DoDiskSpaceLimiting = &limiting
+	}
+	update.MaxDiskSpaceUsage = getOptionalInt64(d, maxDiskSpaceUsageKey)
+	update.MinFileSizeForCompression = getOptionalInt64(d, minFileSizeForCompressionKey)
+	update.StaticCompressionEnableCpuUsage = getOptionalInt64(d, staticCompressionEnableCpuUsageKey)
+	update.StaticCompressionDisableCpuUsage = getOptionalInt64(d, staticCompressionDisableCpuUsageKey)
+	update.DynamicCompressionEnableCpuUsage = getOptionalInt64(d, dynamicCompressionEnableCpuUsageKey)
+	update.DynamicCompressionDisableCpuUsage = getOptionalInt64(d, dynamicCompressionDisableCpuUsageKey)
+	return update
+}
+
+func getOptionalInt64(d *schema.ResourceData, key string) *int64 {
+	if !isConfigured(d, key) {
+		return nil
+	}
+	value := int64(d.Get(key).(int))
+	return &value
+}
+
+// validateResponseCompressionScope rejects server level settings for website
+// and application scopes, where IIS doesn't allow changing them.
+func validateResponseCompressionScope(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.GetAttr(WebsiteKey).IsNull() && config.GetAttr(ApplicationKey).IsNull() {
+		return nil
+	}
+	for _, key := range serverCompressionKeys {
+		if !config.GetAttr(key).IsNull() {
+			return fmt.Errorf("%s can only be configured at the web server level", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const allowKeepAliveKey = "allow_keep_alive"
+const customHeaderKey = "custom_header"
+const redirectHeaderKey = "redirect_header"
+const removedCustomHeadersKey = "removed_custom_headers"
+
+const headerNameKey = "name"
+const headerValueKey = "value"
+
+func resourceResponseHeaders() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResponseHeadersCreate,
+		ReadContext:   resourceResponseHeadersRead,
+		UpdateContext: resourceResponseHeadersUpdate,
+		DeleteContext: resourceResponseHeadersDelete,
+		CustomizeDiff: validateRemovedCustomHeaders,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			allowKeepAliveKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			customHeaderKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     responseHeaderSchema,
+			},
+			redirectHeaderKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     responseHeaderSchema,
+			},
+			removedCustomHeadersKey: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of custom headers, e.g. inherited ones like X-Powered-By, which must not be sent",
+			},
+		}),
+	}
+}
+
+var responseHeaderSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		headerNameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		headerValueKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+func resourceResponseHeadersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating response headers: "+toJSON(scope))
+	headers, err := client.ReadResponseHeadersFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateResponseHeaders(ctx, d, client, headers); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created response headers: "+toJSON(headers.ID))
+	d.SetId(headers.ID)
+	return resourceResponseHeadersRead(ctx, d, m)
+}
+
+func resourceResponseHeadersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	headers, err := client.ReadResponseHeaders(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read response headers: "+toJSON(headers))
+	customHeaders, err := client.ListCustomHeaders(ctx, headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	redirectHeaders, err := client.ListRedirectHeaders(ctx, headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var removed []string
+	for _, name := range d.Get(removedCustomHeadersKey).(*schema.Set).List() {
+		if !containsResponseHeader(customHeaders, name.(string)) {
+			removed = append(removed, name.(string))
+		}
+	}
+	customHeaders = managedEntries(customHeaders, responseHeaderKey, getResponseHeaders(d.Get(customHeaderKey)))
+	redirectHeaders = managedEntries(redirectHeaders, responseHeaderKey, getResponseHeaders(d.Get(redirectHeaderKey)))
+
+	if err = d.Set(allowKeepAliveKey, headers.AllowKeepAlive); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(customHeaderKey, mapResponseHeadersToList(customHeaders)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(redirectHeaderKey, mapResponseHeadersToList(redirectHeaders)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(removedCustomHeadersKey, removed); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceResponseHeadersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating response headers: "+toJSON(d.Id()))
+	headers, err := client.ReadResponseHeaders(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateResponseHeaders(ctx, d, client, headers); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated response headers: "+toJSON(d.Id()))
+	return resourceResponseHeadersRead(ctx, d, m)
+}
+
+func resourceResponseHeadersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting response headers: "+toJSON(d.Id()))
+	headers, err := client.ReadResponseHeaders(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncCustomHeaders(ctx, client, headers, getResponseHeaders(d.Get(customHeaderKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncRedirectHeaders(ctx, client, headers, getResponseHeaders(d.Get(redirectHeaderKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted response headers: "+toJSON(d.Id()))
+	return nil
+}
+
+// validateRemovedCustomHeaders rejects headers which are both configured and
+// removed, as they would otherwise be added and deleted again on every apply.
+func validateRemovedCustomHeaders(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(customHeaderKey) || !d.NewValueKnown(removedCustomHeadersKey) {
+		return nil
+	}
+	customHeaders := getResponseHeaders(d.Get(customHeaderKey))
+	for _, name := range d.Get(removedCustomHeadersKey).(*schema.Set).List() {
+		if containsResponseHeader(customHeaders, name.(string)) {
+			return fmt.Errorf("header %q is listed in both %s and %s", name, customHeaderKey, removedCustomHeadersKey)
+		}
+	}
+	return nil
+}
+
+func updateResponseHeaders(ctx context.Context, d *schema.ResourceData, client *iis.Client, headers *iis.ResponseHeaders) error {
+	allowKeepAlive := d.Get(allowKeepAliveKey).(bool)
+	if allowKeepAlive != headers.AllowKeepAlive {
+		if _, err := client.UpdateResponseHeaders(ctx, headers.ID, allowKeepAlive); err != nil {
+			return err
+		}
+	}
+	oldCustom, newCustom := d.GetChange(customHeaderKey)
+	if err := syncCustomHeaders(ctx, client, headers, getResponseHeaders(oldCustom), getResponseHeaders(newCustom)); err != nil {
+		return err
+	}
+	oldRedirect, newRedirect := d.GetChange(redirectHeaderKey)
+	if err := syncRedirectHeaders(ctx, client, headers, getResponseHeaders(oldRedirect), getResponseHeaders(newRedirect)); err != nil {
+		return err
+	}
+	existing, err := client.ListCustomHeaders(ctx, headers)
+	if err != nil {
+		return err
+	}
+	for _, name := range d.Get(removedCustomHeadersKey).(*schema.Set).List() {
+		for _, header := range existing {
+			if strings.EqualFold(header.Name, name.(string)) {
+				if err := client.DeleteCustomHeader(ctx, header.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func syncCustomHeaders(ctx context.Context, client *iis.Client, headers *iis.ResponseHeaders, previous, desired []iis.ResponseHeader) error {
+	existing, err := client.ListCustomHeaders(ctx, headers)
+	if err != nil {
+		return err
+	}
+	existing = managedEntries(existing, responseHeaderKey, previous, desired)
+	return syncCollection(existing, desired, responseHeaderContent,
+		func(header iis.ResponseHeader) error {
+			return client.DeleteCustomHeader(ctx, header.ID)
+		},
+		func(header iis.ResponseHeader) error {
+			_, err := client.CreateCustomHeader(ctx, headers, header)
+			return err
+		})
+}
+
+func syncRedirectHeaders(ctx context.Context, client *iis.Client, headers *iis.ResponseHeaders, previous, desired []iis.ResponseHeader) error {
+	existing, err := client.ListRedirectHeaders(ctx, headers)
+	if err != nil {
+		return err
+	}
+	existing = managedEntries(existing, responseHeaderKey, previous, desired)
+	return syncCollection(existing, desired, responseHeaderContent,
+		func(header iis.ResponseHeader) error {
+			return client.DeleteRedirectHeader(ctx, header.ID)
+		},
+		func(header iis.ResponseHeader) error {
+			_, err := client.CreateRedirectHeader(ctx, headers, header)
+			return err
+		})
+}
+
+func getResponseHeaders(v interface{}) []iis.ResponseHeader {
+	var headers []iis.ResponseHeader
+	for _, entry := range v.(*schema.Set).List() {
+		header := entry.(map[string]interface{})
+		headers = append(headers, iis.ResponseHeader{
+			Name:  header[headerNameKey].(string),
+			Value: header[headerValueKey].(string),
+		})
+	}
+	return headers
+}
+
+func mapResponseHeadersToList(headers []iis.ResponseHeader) []interface{} {
+	list := make([]interface{}, len(headers))
+	for i, header := range headers {
+		list[i] = map[string]interface{}{
+			headerNameKey:  header.Name,
+			headerValueKey: header.Value,
+		}
+	}
+	return list
+}
+
+func containsResponseHeader(headers []iis.ResponseHeader, name string) bool {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func responseHeaderKey(header iis.ResponseHeader) string {
+	return strings.ToLower(header.Name)
+}
+
+func responseHeaderContent(header iis.ResponseHeader) string {
+	return responseHeaderKey(header) + ":" + header.Value
+}
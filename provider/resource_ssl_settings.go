@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const requireSslKey = "require_ssl"
+const require128BitKey = "require_128_bit"
+const clientCertificatesKey = "client_certificates"
+
+func resourceSslSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSslSettingsCreate,
+		ReadContext:   resourceSslSettingsRead,
+		UpdateContext: resourceSslSettingsUpdate,
+		DeleteContext: resourceSslSettingsDelete,
+		Importer:      importFeatureScope(fetchSslSettingsId),
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			requireSslKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			require128BitKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			clientCertificatesKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ignore",
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "accept", "require"}, false),
+			},
+		}),
+	}
+}
+
+func fetchSslSettingsId(ctx context.Context, client *iis.Client, scope iis.FeatureScope) (string, error) {
+	settings, err := client.ReadSslSettingsFromScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	return settings.ID, nil
+}
+
+func resourceSslSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating ssl settings: "+toJSON(scope))
+	settings, err := client.ReadSslSettingsFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	settings, err = client.UpdateSslSettings(ctx, getSslSettings(d, settings.ID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created ssl settings: "+toJSON(settings))
+	d.SetId(settings.ID)
+	return resourceSslSettingsRead(ctx, d, m)
+}
+
+func resourceSslSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	settings, err := client.ReadSslSettings(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ssl settings: "+toJSON(settings))
+	if err = d.Set(requireSslKey, settings.RequireSsl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(require128BitKey, settings.Require128Bit); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(clientCertificatesKey, settings.ClientCertificates); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceSslSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getSslSettings(d, d.Id())
+	tflog.Debug(ctx, "Updating ssl settings: "+toJSON(request))
+	settings, err := client.UpdateSslSettings(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated ssl settings: "+toJSON(settings))
+	return resourceSslSettingsRead(ctx, d, m)
+}
+
+func resourceSslSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting ssl settings: "+toJSON(d.Id()))
+	_, err := client.UpdateSslSettings(ctx, &iis.SslSettings{
+		ID:                 d.Id(),
+		ClientCertificates: "ignore",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted ssl settings: "+toJSON(d.Id()))
+	return nil
+}
+
+func getSslSettings(d *schema.ResourceData, id string) *iis.SslSettings {
+	return &iis.SslSettings{
+		ID:                 id,
+		RequireSsl:         d.Get(requireSslKey).(bool),
+		Require128Bit:      d.Get(require128BitKey).(bool),
+		ClientCertificates: d.Get(clientCertificatesKey).(string),
+	}
+}
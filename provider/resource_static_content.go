@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const clientCacheControlModeKey = "client_cache_control_mode"
+const clientCacheMaxAgeKey = "client_cache_max_age"
+const clientCacheExpiresKey = "client_cache_expires"
+const defaultDocFooterKey = "default_doc_footer"
+const enableDocFooterKey = "enable_doc_footer"
+const mimeMapKey = "mime_map"
+
+const fileExtensionMimeKey = "file_extension"
+const mimeTypeKey = "mime_type"
+
+func resourceStaticContent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStaticContentCreate,
+		ReadContext:   resourceStaticContentRead,
+		UpdateContext: resourceStaticContentUpdate,
+		DeleteContext: resourceStaticContentDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			clientCacheControlModeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NoControl",
+				ValidateFunc: validation.StringInSlice([]string{"NoControl", "DisableCache", "UseMaxAge", "UseExpires"}, false),
+			},
+			clientCacheMaxAgeKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1440,
+				Description: "Max age in minutes, used with the UseMaxAge control mode",
+			},
+			clientCacheExpiresKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "HTTP date, used with the UseExpires control mode",
+			},
+			defaultDocFooterKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			enableDocFooterKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			mimeMapKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						fileExtensionMimeKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						mimeTypeKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceStaticContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating static content: "+toJSON(scope))
+	content, err := client.ReadStaticContentFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateStaticContent(ctx, d, client, content); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created static content: "+toJSON(content.ID))
+	d.SetId(content.ID)
+	return resourceStaticContentRead(ctx, d, m)
+}
+
+func resourceStaticContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	content, err := client.ReadStaticContent(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read static content: "+toJSON(content))
+	mimeMaps, err := client.ListMimeMaps(ctx, content)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mimeMaps = managedEntries(mimeMaps, mimeMapEntryKey, getMimeMaps(d.Get(mimeMapKey)))
+	mimeMapList := make([]interface{}, len(mimeMaps))
+	for i, mimeMap := range mimeMaps {
+		mimeMapList[i] = map[string]interface{}{
+			fileExtensionMimeKey: mimeMap.FileExtension,
+			mimeTypeKey:          mimeMap.MimeType,
+		}
+	}
+	values := map[string]interface{}{
+		clientCacheControlModeKey: content.ClientCache.ControlMode,
+		clientCacheMaxAgeKey:      content.ClientCache.MaxAge,
+		clientCacheExpiresKey:     content.ClientCache.HttpExpires,
+		defaultDocFooterKey:       content.DefaultDocFooter,
+		enableDocFooterKey:        content.EnableDocFooter,
+		mimeMapKey:                mimeMapList,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceStaticContentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating static content: "+toJSON(d.Id()))
+	content, err := client.ReadStaticContent(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateStaticContent(ctx, d, client, content); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated static content: "+toJSON(d.Id()))
+	return resourceStaticContentRead(ctx, d, m)
+}
+
+func resourceStaticContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting static content: "+toJSON(d.Id()))
+	content, err := client.ReadStaticContent(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncMimeMaps(ctx, client, content, getMimeMaps(d.Get(mimeMapKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted static content: "+toJSON(d.Id()))
+	return nil
+}
+
+func updateStaticContent(ctx context.Context, d *schema.ResourceData, client *iis.Client, content *iis.StaticContent) error {
+	content.ClientCache = iis.ClientCache{
+		ControlMode: d.Get(clientCacheControlModeKey).(string),
+		MaxAge:      int64(d.Get(clientCacheMaxAgeKey).(int)),
+		HttpExpires: d.Get(clientCacheExpiresKey).(string),
+	}
+	content.DefaultDocFooter = d.Get(defaultDocFooterKey).(string)
+	content.EnableDocFooter = d.Get(enableDocFooterKey).(bool)
+	if _, err := client.UpdateStaticContent(ctx, content); err != nil {
+		return err
+	}
+	previous, desired := d.GetChange(mimeMapKey)
+	return syncMimeMaps(ctx, client, content, getMimeMaps(previous), getMimeMaps(desired))
+}
+
+// syncMimeMaps only touches the mime maps tracked by this resource, so it can
+// be combined with iis_mime_map resources for the same scope.
+func syncMimeMaps(ctx context.Context, client *iis.Client, content *iis.StaticContent, previous, desired []iis.MimeMap) error {
+	existing, err := client.ListMimeMaps(ctx, content)
+	if err != nil {
+		return err
+	}
+	existing = managedEntries(existing, mimeMapEntryKey, previous, desired)
+	return syncCollection(existing, desired, mimeMapContent,
+		func(mimeMap iis.MimeMap) error {
+			return client.DeleteMimeMap(ctx, mimeMap.ID)
+		},
+		func(mimeMap iis.MimeMap) error {
+			_, err := client.CreateMimeMap(ctx, content, mimeMap)
+			return err
+		})
+}
+
+func getMimeMaps(v interface{}) []iis.MimeMap {
+	var mimeMaps []iis.MimeMap
+	for _, entry := range v.(*schema.Set).List() {
+		mimeMap := entry.(map[string]interface{})
+		mimeMaps = append(mimeMaps, iis.MimeMap{
+			FileExtension: mimeMap[fileExtensionMimeKey].(string),
+			MimeType:      mimeMap[mimeTypeKey].(string),
+		})
+	}
+	return mimeMaps
+}
+
+func mimeMapEntryKey(mimeMap iis.MimeMap) string {
+	return strings.ToLower(mimeMap.FileExtension)
+}
+
+func mimeMapContent(mimeMap iis.MimeMap) string {
+	return mimeMapEntryKey(mimeMap) + "=" + mimeMap.MimeType
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const serverVariablesKey = "server_variables"
+
+func resourceUrlRewriteAllowedServerVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteAllowedServerVariablesCreate,
+		ReadContext:   resourceUrlRewriteAllowedServerVariablesRead,
+		UpdateContext: resourceUrlRewriteAllowedServerVariablesUpdate,
+		DeleteContext: resourceUrlRewriteAllowedServerVariablesDelete,
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			serverVariablesKey: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Server variables rules may set, e.g. HTTP_X_FORWARDED_HOST",
+			},
+		}),
+	}
+}
+
+func resourceUrlRewriteAllowedServerVariablesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	scope := getFeatureScope(d)
+	tflog.Debug(ctx, "Creating allowed server variables: "+toJSON(scope))
+	variables, err := client.ReadAllowedServerVariablesFromScope(ctx, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateAllowedServerVariables(ctx, client, variables, nil, getServerVariables(d.Get(serverVariablesKey))); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created allowed server variables: "+toJSON(variables.ID))
+	d.SetId(variables.ID)
+	return resourceUrlRewriteAllowedServerVariablesRead(ctx, d, m)
+}
+
+func resourceUrlRewriteAllowedServerVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	variables, err := client.ReadAllowedServerVariables(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read allowed server variables: "+toJSON(variables))
+	var managed []string
+	for _, name := range getServerVariables(d.Get(serverVariablesKey)) {
+		if containsFold(variables.Entries, name) {
+			managed = append(managed, name)
+		}
+	}
+	if err = d.Set(serverVariablesKey, managed); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteAllowedServerVariablesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Updating allowed server variables: "+toJSON(d.Id()))
+	variables, err := client.ReadAllowedServerVariables(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	previous, desired := d.GetChange(serverVariablesKey)
+	if err := updateAllowedServerVariables(ctx, client, variables, getServerVariables(previous), getServerVariables(desired)); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated allowed server variables: "+toJSON(d.Id()))
+	return resourceUrlRewriteAllowedServerVariablesRead(ctx, d, m)
+}
+
+func resourceUrlRewriteAllowedServerVariablesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	tflog.Debug(ctx, "Deleting allowed server variables: "+toJSON(d.Id()))
+	variables, err := client.ReadAllowedServerVariables(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateAllowedServerVariables(ctx, client, variables, getServerVariables(d.Get(serverVariablesKey)), nil); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted allowed server variables: "+toJSON(d.Id()))
+	return nil
+}
+
+// updateAllowedServerVariables replaces the previously managed variables by
+// the desired ones, keeping variables allowed outside of Terraform.
+func updateAllowedServerVariables(ctx context.Context, client *iis.Client, variables *iis.AllowedServerVariables, previous, desired []string) error {
+	entries := []string{}
+	for _, name := range variables.Entries {
+		if !containsFold(previous, name) && !containsFold(desired, name) {
+			entries = append(entries, name)
+		}
+	}
+	entries = append(entries, desired...)
+	_, err := client.UpdateAllowedServerVariables(ctx, variables.ID, entries)
+	return err
+}
+
+func getServerVariables(v interface{}) []string {
+	return toStringList(v.(*schema.Set).List())
+}
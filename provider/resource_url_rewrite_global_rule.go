@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceUrlRewriteGlobalRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteGlobalRuleCreate,
+		ReadContext:   resourceUrlRewriteGlobalRuleRead,
+		UpdateContext: resourceUrlRewriteGlobalRuleUpdate,
+		DeleteContext: resourceUrlRewriteGlobalRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGlobalRule,
+		},
+
+		Schema: globalRuleSchema(),
+	}
+}
+
+// globalRuleSchema requires the priority of global rules, as they run before
+// any site rules and their order must not depend on creation order.
+func globalRuleSchema() map[string]*schema.Schema {
+	s := rewriteRuleSchema(map[string]*schema.Schema{})
+	s[rulePriorityKey] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		Description:  "Position of the rule within the global rules, rules are evaluated in ascending order",
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	return s
+}
+
+// importGlobalRule imports a global rule by its name.
+func importGlobalRule(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*providerMeta).client
+	section, err := client.ReadGlobalRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := client.ListGlobalRules(ctx, section)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.Name == d.Id() {
+			d.SetId(rule.ID)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+	return nil, fmt.Errorf("global rule %q not found", d.Id())
+}
+
+func resourceUrlRewriteGlobalRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	section, err := client.ReadGlobalRules(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getRewriteRule(d)
+	tflog.Debug(ctx, "Creating url rewrite global rule: "+toJSON(request))
+	rule, err := client.CreateGlobalRule(ctx, section, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created url rewrite global rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteGlobalRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteGlobalRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	rule, err := client.ReadGlobalRule(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read url rewrite global rule: "+toJSON(rule))
+	if err = setRewriteRule(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteGlobalRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getRewriteRule(d)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating url rewrite global rule: "+toJSON(request))
+	rule, err := client.UpdateGlobalRule(ctx, &request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated url rewrite global rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteGlobalRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteGlobalRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting url rewrite global rule: "+toJSON(id))
+	if err := client.DeleteGlobalRule(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted url rewrite global rule: "+toJSON(id))
+	return nil
+}
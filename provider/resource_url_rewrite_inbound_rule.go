@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceUrlRewriteInboundRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteInboundRuleCreate,
+		ReadContext:   resourceUrlRewriteInboundRuleRead,
+		UpdateContext: resourceUrlRewriteInboundRuleUpdate,
+		DeleteContext: resourceUrlRewriteInboundRuleDelete,
+		Importer:      importFeatureEntry(fetchInboundRuleId),
+
+		Schema: withFeatureScope(rewriteRuleSchema(map[string]*schema.Schema{})),
+	}
+}
+
+func fetchInboundRuleId(ctx context.Context, client *iis.Client, scope iis.FeatureScope, name string) (string, error) {
+	section, err := client.ReadInboundRulesFromScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	rules, err := client.ListInboundRules(ctx, section)
+	if err != nil {
+		return "", err
+	}
+	for _, rule := range rules {
+		if rule.Name == name {
+			return rule.ID, nil
+		}
+	}
+	return "", fmt.Errorf("inbound rule %q not found", name)
+}
+
+func resourceUrlRewriteInboundRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	section, err := client.ReadInboundRulesFromScope(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getRewriteRule(d)
+	tflog.Debug(ctx, "Creating url rewrite inbound rule: "+toJSON(request))
+	rule, err := client.CreateInboundRule(ctx, section, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created url rewrite inbound rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteInboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteInboundRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	rule, err := client.ReadInboundRule(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read url rewrite inbound rule: "+toJSON(rule))
+	if err = setRewriteRule(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteInboundRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getRewriteRule(d)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating url rewrite inbound rule: "+toJSON(request))
+	rule, err := client.UpdateInboundRule(ctx, &request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated url rewrite inbound rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteInboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteInboundRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting url rewrite inbound rule: "+toJSON(id))
+	if err := client.DeleteInboundRule(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted url rewrite inbound rule: "+toJSON(id))
+	return nil
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const rewriteMapDefaultValueKey = "default_value"
+const rewriteMapEntriesKey = "entries"
+
+func resourceUrlRewriteMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteMapCreate,
+		ReadContext:   resourceUrlRewriteMapRead,
+		UpdateContext: resourceUrlRewriteMapUpdate,
+		DeleteContext: resourceUrlRewriteMapDelete,
+		Importer:      importServerFeatureEntry(fetchRewriteMapId),
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			nameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			rewriteMapDefaultValueKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			ruleIgnoreCaseKey: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			rewriteMapEntriesKey: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Mappings from original to new values, e.g. loaded with csvdecode",
+			},
+		}),
+	}
+}
+
+func fetchRewriteMapId(ctx context.Context, client *iis.Client, scope iis.FeatureScope, name string) (string, error) {
+	section, err := client.ReadRewriteMapsFromScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	rewriteMaps, err := client.ListRewriteMaps(ctx, section)
+	if err != nil {
+		return "", err
+	}
+	for _, rewriteMap := range rewriteMaps {
+		if rewriteMap.Name == name {
+			return rewriteMap.ID, nil
+		}
+	}
+	return "", fmt.Errorf("rewrite map %q not found", name)
+}
+
+func resourceUrlRewriteMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	section, err := client.ReadRewriteMapsFromScope(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getRewriteMap(d)
+	tflog.Debug(ctx, "Creating url rewrite map: "+toJSON(request))
+	rewriteMap, err := client.CreateRewriteMap(ctx, section, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created url rewrite map: "+toJSON(rewriteMap))
+	d.SetId(rewriteMap.ID)
+	if err := syncRewriteMapEntries(ctx, client, rewriteMap, getRewriteMapEntries(d.Get(rewriteMapEntriesKey))); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceUrlRewriteMapRead(ctx, d, m)
+}
+
+func resourceUrlRewriteMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	rewriteMap, err := client.ReadRewriteMap(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read url rewrite map: "+toJSON(rewriteMap))
+	entries, err := client.ListRewriteMapEntries(ctx, rewriteMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mappings := make(map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		mappings[entry.OriginalValue] = entry.NewValue
+	}
+	if err = d.Set(nameKey, rewriteMap.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(rewriteMapDefaultValueKey, rewriteMap.DefaultValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(ruleIgnoreCaseKey, rewriteMap.IgnoreCase); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set(rewriteMapEntriesKey, mappings); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	rewriteMap, err := client.ReadRewriteMap(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(nameKey, rewriteMapDefaultValueKey, ruleIgnoreCaseKey) {
+		request := getRewriteMap(d)
+		request.ID = d.Id()
+		tflog.Debug(ctx, "Updating url rewrite map: "+toJSON(request))
+		rewriteMap, err = client.UpdateRewriteMap(ctx, &request)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tflog.Debug(ctx, "Updated url rewrite map: "+toJSON(rewriteMap))
+	}
+	if d.HasChange(rewriteMapEntriesKey) {
+		if err := syncRewriteMapEntries(ctx, client, rewriteMap, getRewriteMapEntries(d.Get(rewriteMapEntriesKey))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceUrlRewriteMapRead(ctx, d, m)
+}
+
+func resourceUrlRewriteMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting url rewrite map: "+toJSON(id))
+	if err := client.DeleteRewriteMap(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted url rewrite map: "+toJSON(id))
+	return nil
+}
+
+// syncRewriteMapEntries only sends the mappings which differ from the ones
+// in IIS, as maps often hold thousands of entries.
+func syncRewriteMapEntries(ctx context.Context, client *iis.Client, rewriteMap *iis.RewriteMap, desired map[string]string) error {
+	existing, err := client.ListRewriteMapEntries(ctx, rewriteMap)
+	if err != nil {
+		return err
+	}
+	present := make(map[string]bool, len(existing))
+	for _, entry := range existing {
+		newValue, wanted := desired[entry.OriginalValue]
+		if !wanted || present[entry.OriginalValue] {
+			tflog.Debug(ctx, "Deleting url rewrite map entry: "+toJSON(entry))
+			if err := client.DeleteRewriteMapEntry(ctx, entry.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		present[entry.OriginalValue] = true
+		if entry.NewValue != newValue {
+			entry.NewValue = newValue
+			tflog.Debug(ctx, "Updating url rewrite map entry: "+toJSON(entry))
+			if _, err := client.UpdateRewriteMapEntry(ctx, &entry); err != nil {
+				return err
+			}
+		}
+	}
+	for originalValue, newValue := range desired {
+		if present[originalValue] {
+			continue
+		}
+		entry := iis.RewriteMapEntry{OriginalValue: originalValue, NewValue: newValue}
+		tflog.Debug(ctx, "Creating url rewrite map entry: "+toJSON(entry))
+		if _, err := client.CreateRewriteMapEntry(ctx, rewriteMap, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getRewriteMap(d *schema.ResourceData) iis.RewriteMap {
+	return iis.RewriteMap{
+		Name:         d.Get(nameKey).(string),
+		DefaultValue: d.Get(rewriteMapDefaultValueKey).(string),
+		IgnoreCase:   d.Get(ruleIgnoreCaseKey).(bool),
+	}
+}
+
+func getRewriteMapEntries(v interface{}) map[string]string {
+	entries := make(map[string]string)
+	for originalValue, newValue := range v.(map[string]interface{}) {
+		entries[originalValue] = newValue.(string)
+	}
+	return entries
+}
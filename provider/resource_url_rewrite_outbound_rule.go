@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const outboundPreconditionKey = "precondition"
+const outboundMatchTypeKey = "match_type"
+const outboundServerVariableKey = "server_variable"
+const outboundTagFiltersKey = "tag_filters"
+const outboundCustomTagsKey = "custom_tags"
+const outboundActionValueKey = "value"
+const outboundActionReplaceKey = "replace_server_variable"
+
+// outboundTags are the html tags outbound rules can match attributes of.
+var outboundTags = []string{"a", "area", "base", "form", "frame", "head", "iframe", "img", "input", "link", "script"}
+
+func resourceUrlRewriteOutboundRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteOutboundRuleCreate,
+		ReadContext:   resourceUrlRewriteOutboundRuleRead,
+		UpdateContext: resourceUrlRewriteOutboundRuleUpdate,
+		DeleteContext: resourceUrlRewriteOutboundRuleDelete,
+		Importer:      importFeatureEntry(fetchOutboundRuleId),
+
+		Schema: withFeatureScope(rewriteMatchSchema(map[string]*schema.Schema{
+			outboundPreconditionKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the precondition responses have to match",
+			},
+			outboundMatchTypeKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tags",
+				ValidateFunc: validation.StringInSlice([]string{"tags", "server_variable"}, false),
+			},
+			outboundServerVariableKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server variable to match when match_type is server_variable, e.g. RESPONSE_Location",
+			},
+			outboundTagFiltersKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(outboundTags, false),
+				},
+			},
+			outboundCustomTagsKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the custom tags collection to match",
+			},
+			ruleActionKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						actionTypeKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"none", "rewrite"}, false),
+						},
+						outboundActionValueKey: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						outboundActionReplaceKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		})),
+	}
+}
+
+func fetchOutboundRuleId(ctx context.Context, client *iis.Client, scope iis.FeatureScope, name string) (string, error) {
+	section, err := client.ReadOutboundRulesFromScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	rules, err := client.ListOutboundRules(ctx, section)
+	if err != nil {
+		return "", err
+	}
+	for _, rule := range rules {
+		if rule.Name == name {
+			return rule.ID, nil
+		}
+	}
+	return "", fmt.Errorf("outbound rule %q not found", name)
+}
+
+func resourceUrlRewriteOutboundRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	section, err := client.ReadOutboundRulesFromScope(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getOutboundRule(d)
+	tflog.Debug(ctx, "Creating url rewrite outbound rule: "+toJSON(request))
+	rule, err := client.CreateOutboundRule(ctx, section, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created url rewrite outbound rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteOutboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteOutboundRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	rule, err := client.ReadOutboundRule(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read url rewrite outbound rule: "+toJSON(rule))
+	if err = setOutboundRule(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteOutboundRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getOutboundRule(d)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating url rewrite outbound rule: "+toJSON(request))
+	rule, err := client.UpdateOutboundRule(ctx, &request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated url rewrite outbound rule: "+toJSON(rule))
+	d.SetId(rule.ID)
+	return resourceUrlRewriteOutboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteOutboundRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting url rewrite outbound rule: "+toJSON(id))
+	if err := client.DeleteOutboundRule(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted url rewrite outbound rule: "+toJSON(id))
+	return nil
+}
+
+func getOutboundRule(d *schema.ResourceData) iis.OutboundRule {
+	filters := toStringList(d.Get(outboundTagFiltersKey).(*schema.Set).List())
+	tagFilters := make(map[string]bool, len(outboundTags))
+	for _, tag := range outboundTags {
+		tagFilters[tag] = containsFold(filters, tag)
+	}
+	action := getNestedMap(d, ruleActionKey)
+	return iis.OutboundRule{
+		RewriteMatch:   getRewriteMatch(d),
+		Precondition:   d.Get(outboundPreconditionKey).(string),
+		MatchType:      d.Get(outboundMatchTypeKey).(string),
+		ServerVariable: d.Get(outboundServerVariableKey).(string),
+		TagFilters:     tagFilters,
+		CustomTags:     d.Get(outboundCustomTagsKey).(string),
+		Action: iis.OutboundAction{
+			Type:                  action[actionTypeKey].(string),
+			Value:                 action[outboundActionValueKey].(string),
+			ReplaceServerVariable: action[outboundActionReplaceKey].(bool),
+		},
+	}
+}
+
+func setOutboundRule(d *schema.ResourceData, rule *iis.OutboundRule) error {
+	if err := setRewriteMatch(d, rule.RewriteMatch); err != nil {
+		return err
+	}
+	var tagFilters []interface{}
+	for _, tag := range outboundTags {
+		if rule.TagFilters[tag] {
+			tagFilters = append(tagFilters, tag)
+		}
+	}
+	values := map[string]interface{}{
+		outboundPreconditionKey:   rule.Precondition,
+		outboundMatchTypeKey:      rule.MatchType,
+		outboundServerVariableKey: rule.ServerVariable,
+		outboundTagFiltersKey:     tagFilters,
+		outboundCustomTagsKey:     rule.CustomTags,
+		ruleActionKey: []interface{}{
+			map[string]interface{}{
+				actionTypeKey:            rule.Action.Type,
+				outboundActionValueKey:   rule.Action.Value,
+				outboundActionReplaceKey: rule.Action.ReplaceServerVariable,
+			},
+		},
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const preconditionMatchKey = "match"
+const preconditionPatternKey = "pattern"
+
+func resourceUrlRewritePrecondition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewritePreconditionCreate,
+		ReadContext:   resourceUrlRewritePreconditionRead,
+		UpdateContext: resourceUrlRewritePreconditionUpdate,
+		DeleteContext: resourceUrlRewritePreconditionDelete,
+		Importer:      importFeatureEntry(fetchPreconditionId),
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			nameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			preconditionMatchKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "any"}, false),
+			},
+			ruleSyntaxKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ecmascript",
+				ValidateFunc: validation.StringInSlice([]string{"ecmascript", "wildcard", "exact_match"}, false),
+			},
+			preconditionPatternKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						conditionInputKey: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Input of the pattern, e.g. {RESPONSE_CONTENT_TYPE}",
+						},
+						rulePatternKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						ruleNegateKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						ruleIgnoreCaseKey: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func fetchPreconditionId(ctx context.Context, client *iis.Client, scope iis.FeatureScope, name string) (string, error) {
+	section, err := client.ReadOutboundRulesFromScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	preconditions, err := client.ListPreconditions(ctx, section)
+	if err != nil {
+		return "", err
+	}
+	for _, precondition := range preconditions {
+		if precondition.Name == name {
+			return precondition.ID, nil
+		}
+	}
+	return "", fmt.Errorf("precondition %q not found", name)
+}
+
+func resourceUrlRewritePreconditionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	section, err := client.ReadOutboundRulesFromScope(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := getPrecondition(d)
+	tflog.Debug(ctx, "Creating url rewrite precondition: "+toJSON(request))
+	precondition, err := client.CreatePrecondition(ctx, section, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created url rewrite precondition: "+toJSON(precondition))
+	d.SetId(precondition.ID)
+	return resourceUrlRewritePreconditionRead(ctx, d, m)
+}
+
+func resourceUrlRewritePreconditionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	precondition, err := client.ReadPrecondition(ctx, d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read url rewrite precondition: "+toJSON(precondition))
+	if err = setPrecondition(d, precondition); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewritePreconditionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	request := getPrecondition(d)
+	request.ID = d.Id()
+	tflog.Debug(ctx, "Updating url rewrite precondition: "+toJSON(request))
+	precondition, err := client.UpdatePrecondition(ctx, &request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated url rewrite precondition: "+toJSON(precondition))
+	d.SetId(precondition.ID)
+	return resourceUrlRewritePreconditionRead(ctx, d, m)
+}
+
+func resourceUrlRewritePreconditionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting url rewrite precondition: "+toJSON(id))
+	if err := client.DeletePrecondition(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted url rewrite precondition: "+toJSON(id))
+	return nil
+}
+
+func getPrecondition(d *schema.ResourceData) iis.RewritePrecondition {
+	precondition := iis.RewritePrecondition{
+		Name:          d.Get(nameKey).(string),
+		Match:         d.Get(preconditionMatchKey).(string),
+		PatternSyntax: patternSyntaxes[d.Get(ruleSyntaxKey).(string)],
+		Patterns:      []iis.PreconditionPattern{},
+	}
+	for _, entry := range getList(d, preconditionPatternKey) {
+		pattern := entry.(map[string]interface{})
+		precondition.Patterns = append(precondition.Patterns, iis.PreconditionPattern{
+			Input:      pattern[conditionInputKey].(string),
+			Pattern:    pattern[rulePatternKey].(string),
+			Negate:     pattern[ruleNegateKey].(bool),
+			IgnoreCase: pattern[ruleIgnoreCaseKey].(bool),
+		})
+	}
+	return precondition
+}
+
+func setPrecondition(d *schema.ResourceData, precondition *iis.RewritePrecondition) error {
+	patterns := make([]interface{}, len(precondition.Patterns))
+	for i, pattern := range precondition.Patterns {
+		patterns[i] = map[string]interface{}{
+			conditionInputKey: pattern.Input,
+			rulePatternKey:    pattern.Pattern,
+			ruleNegateKey:     pattern.Negate,
+			ruleIgnoreCaseKey: pattern.IgnoreCase,
+		}
+	}
+	values := map[string]interface{}{
+		nameKey:                precondition.Name,
+		preconditionMatchKey:   precondition.Match,
+		ruleSyntaxKey:          patternSyntaxName(precondition.PatternSyntax),
+		preconditionPatternKey: patterns,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +19,8 @@ const bindingProtocolKey = "protocol"
 const bindingPortKey = "port"
 const bindingAddressKey = "ip_address"
 const bindingHostKey = "hostname"
+const bindingCertificateKey = "certificate_thumbprint"
+const bindingCentralCertificateStoreKey = "use_central_certificate_store"
 
 func resourceWebsite() *schema.Resource {
 	return &schema.Resource{
@@ -25,6 +28,7 @@ func resourceWebsite() *schema.Resource {
 		ReadContext:   resourceWebsiteRead,
 		UpdateContext: resourceWebsiteUpdate,
 		DeleteContext: resourceWebsiteDelete,
+		CustomizeDiff: validateWebsiteCertificates,
 
 		Schema: map[string]*schema.Schema{
 			nameKey: {
@@ -42,9 +46,13 @@ func resourceWebsite() *schema.Resource {
 			bindingsKey: {
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
 				Elem:     bindingSchema,
 			},
+			validatePhysicalPathKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check that the physical path exists before creating, overriding the provider setting",
+			},
 		},
 	}
 }
@@ -70,51 +78,102 @@ var bindingSchema = &schema.Resource{
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		bindingCertificateKey: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Thumbprint of the certificate used by https bindings",
+		},
+		bindingCentralCertificateStoreKey: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Load the certificate of https bindings from the central certificate store by hostname",
+		},
 	},
 }
 
 func resourceWebsiteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
+	diags := checkPhysicalPath(ctx, d, m.(*providerMeta))
+	if diags.HasError() {
+		return diags
+	}
 	request := createWebsiteRequest(d)
+	if err := resolveBindingCertificates(ctx, client, request.Bindings); err != nil {
+		return diag.FromErr(err)
+	}
+	diags = append(diags, certificateExpiryWarnings(ctx, m.(*providerMeta), request.Bindings)...)
 	tflog.Debug(ctx, "Creating website: "+toJSON(request))
 	site, err := client.CreateWebsite(ctx, request)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 	tflog.Debug(ctx, "Created website: "+toJSON(site))
 	d.SetId(site.ID)
-	return nil
+	return diags
 }
 
 func resourceWebsiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	site, err := readWebsite(ctx, d, m.(*providerMeta).client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return certificateExpiryWarnings(ctx, m.(*providerMeta), site.Bindings)
+}
+
+// readWebsite stores the website in d, leaving the certificate expiry checks
+// to the callers.
+func readWebsite(ctx context.Context, d *schema.ResourceData, client *iis.Client) (*iis.Website, error) {
 	site, err := client.ReadWebsite(ctx, d.Id())
 	if err != nil {
 		d.SetId("")
-		return diag.FromErr(err)
+		return nil, err
 	}
 	tflog.Debug(ctx, "Read website:"+toJSON(site))
 	if err = d.Set(nameKey, site.Name); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 	if err = d.Set(physicalPathKey, site.PhysicalPath); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 	if err = d.Set(appPoolKey, site.ApplicationPool.ID); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 	if err = d.Set(bindingsKey, mapBindingsToSet(site)); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
-	return nil
+	return site, nil
 }
 
 func resourceWebsiteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return nil
+	client := m.(*providerMeta).client
+	if !d.HasChange(bindingsKey) {
+		return nil
+	}
+	site, err := client.ReadWebsite(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	site.Bindings = getBindings(d.Get(bindingsKey).(*schema.Set))
+	if err := resolveBindingCertificates(ctx, client, site.Bindings); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := certificateExpiryWarnings(ctx, m.(*providerMeta), site.Bindings)
+	tflog.Debug(ctx, "Updating website bindings: "+toJSON(site))
+	site, err = client.UpdateWebsite(ctx, *site)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	tflog.Debug(ctx, "Updated website bindings: "+toJSON(site))
+	if _, err := readWebsite(ctx, d, client); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
 }
 
 func resourceWebsiteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*iis.Client)
+	client := m.(*providerMeta).client
 	id := d.Id()
 	err := client.DeleteWebsite(ctx, id)
 	if err != nil {
@@ -151,10 +210,14 @@ func getBindings(b *schema.Set) []iis.WebsiteBinding {
 		hostname := binding[bindingHostKey].(string)
 
 		bindings[i] = iis.WebsiteBinding{
-			Port:      port,
-			IPAddress: ipAddress,
-			Hostname:  hostname,
-			Protocol:  protocol,
+			Port:                       port,
+			IPAddress:                  ipAddress,
+			Hostname:                   hostname,
+			Protocol:                   protocol,
+			UseCentralCertificateStore: binding[bindingCentralCertificateStoreKey].(bool),
+		}
+		if thumbprint := binding[bindingCertificateKey].(string); thumbprint != "" {
+			bindings[i].Certificate = &iis.CertificateReference{Thumbprint: thumbprint}
 		}
 	}
 
@@ -164,11 +227,19 @@ func getBindings(b *schema.Set) []iis.WebsiteBinding {
 func mapBindingsToSet(site *iis.Website) *schema.Set {
 	var bindings []interface{}
 	for _, binding := range site.Bindings {
+		thumbprint := ""
+		// Certificates of the central store are resolved by hostname and
+		// cannot be configured by thumbprint.
+		if binding.Certificate != nil && !binding.UseCentralCertificateStore {
+			thumbprint = binding.Certificate.Thumbprint
+		}
 		bindings = append(bindings, map[string]interface{}{
-			bindingProtocolKey: binding.Protocol,
-			bindingAddressKey:  binding.IPAddress,
-			bindingPortKey:     binding.Port,
-			bindingHostKey:     binding.Hostname,
+			bindingProtocolKey:                binding.Protocol,
+			bindingAddressKey:                 binding.IPAddress,
+			bindingPortKey:                    binding.Port,
+			bindingHostKey:                    binding.Hostname,
+			bindingCertificateKey:             thumbprint,
+			bindingCentralCertificateStoreKey: binding.UseCentralCertificateStore,
 		})
 	}
 	set := schema.NewSet(hashBinding, bindings)
@@ -181,6 +252,8 @@ func hashBinding(v interface{}) int {
 	protocol := schema.HashString(bindingMap[bindingProtocolKey].(string))
 	port := schema.HashInt(bindingMap[bindingPortKey].(int))
 	hostname := schema.HashString(bindingMap[bindingHostKey].(string))
+	certificate := schema.HashString(normalizeThumbprint(bindingMap[bindingCertificateKey].(string)))
+	centralCertificateStore := schema.HashString(strconv.FormatBool(bindingMap[bindingCentralCertificateStoreKey].(bool)))
 
-	return address + protocol + port + hostname
+	return address + protocol + port + hostname + certificate + centralCertificateStore
 }
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const ApplicationKey = "application"
+
+// serverScopeKey prefixes import ids of entries at the web server level.
+const serverScopeKey = "server"
+
+// withFeatureScope adds the application and website attributes used to
+// select which configuration level a feature resource manages.
+func withFeatureScope(s map[string]*schema.Schema) map[string]*schema.Schema {
+	scopeKeys := []string{ApplicationKey, WebsiteKey}
+	s[ApplicationKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: scopeKeys,
+	}
+	s[WebsiteKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: scopeKeys,
+	}
+	return s
+}
+
+// withServerFeatureScope is like withFeatureScope, but manages the web server
+// level when neither application nor website is set.
+func withServerFeatureScope(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s[ApplicationKey] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{WebsiteKey},
+	}
+	s[WebsiteKey] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{ApplicationKey},
+	}
+	return s
+}
+
+func getFeatureScope(d *schema.ResourceData) iis.FeatureScope {
+	return iis.FeatureScope{
+		Application: d.Get(ApplicationKey).(string),
+		Website:     d.Get(WebsiteKey).(string),
+	}
+}
+
+// FetchFeatureId resolves the id of a feature for the given scope.
+type FetchFeatureId func(ctx context.Context, client *iis.Client, scope iis.FeatureScope) (string, error)
+
+// importFeatureScope builds an importer for feature resources. The import id
+// names the scope the feature belongs to, e.g. "website/<id>" or
+// "application/<id>".
+func importFeatureScope(fetch FetchFeatureId) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			client := m.(*providerMeta).client
+			if err := setImportScope(d, d.Id()); err != nil {
+				return nil, err
+			}
+			featureId, err := fetch(ctx, client, getFeatureScope(d))
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(featureId)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// FetchEntryId resolves the id of a named entry of a feature for the given
+// scope.
+type FetchEntryId func(ctx context.Context, client *iis.Client, scope iis.FeatureScope, name string) (string, error)
+
+// importFeatureEntry builds an importer for named entries of a feature, like
+// url rewrite rules. The import id names the scope followed by the entry,
+// e.g. "website/<id>/<name>".
+func importFeatureEntry(fetch FetchEntryId) *schema.ResourceImporter {
+	return importEntry(fetch, false)
+}
+
+// importServerFeatureEntry is like importFeatureEntry, but also accepts
+// "server/<name>" or just "<name>" for entries at the web server level.
+func importServerFeatureEntry(fetch FetchEntryId) *schema.ResourceImporter {
+	return importEntry(fetch, true)
+}
+
+func importEntry(fetch FetchEntryId, serverScope bool) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			client := m.(*providerMeta).client
+			scope, name, found := strings.Cut(d.Id(), "/")
+			switch {
+			case serverScope && !found && scope != "":
+				// "<name>"
+				name = scope
+			case serverScope && scope == serverScopeKey && name != "":
+				// "server/<name>"
+			default:
+				id, entryName, hasName := strings.Cut(name, "/")
+				if !found || !hasName || entryName == "" {
+					if serverScope {
+						return nil, fmt.Errorf("unexpected import id %q, expected %s/<name>, %s/<id>/<name> or %s/<id>/<name>", d.Id(), serverScopeKey, WebsiteKey, ApplicationKey)
+					}
+					return nil, fmt.Errorf("unexpected import id %q, expected %s/<id>/<name> or %s/<id>/<name>", d.Id(), WebsiteKey, ApplicationKey)
+				}
+				if err := setImportScope(d, scope+"/"+id); err != nil {
+					return nil, err
+				}
+				name = entryName
+			}
+			entryId, err := fetch(ctx, client, getFeatureScope(d), name)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(entryId)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func setImportScope(d *schema.ResourceData, importId string) error {
+	key, id, found := strings.Cut(importId, "/")
+	if !found || (key != ApplicationKey && key != WebsiteKey) {
+		return fmt.Errorf("unexpected import id %q, expected %s/<id> or %s/<id>", importId, WebsiteKey, ApplicationKey)
+	}
+	return d.Set(key, id)
+}
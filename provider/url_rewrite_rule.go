@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const rulePatternKey = "pattern"
+const ruleSyntaxKey = "syntax"
+const ruleIgnoreCaseKey = "ignore_case"
+const ruleNegateKey = "negate"
+const ruleStopProcessingKey = "stop_processing"
+const ruleConditionMatchKey = "condition_match"
+const ruleTrackAllCapturesKey = "track_all_captures"
+const ruleConditionKey = "condition"
+const ruleServerVariableKey = "server_variable"
+const ruleActionKey = "action"
+const rulePriorityKey = "priority"
+
+const conditionInputKey = "input"
+const conditionMatchTypeKey = "match_type"
+
+const serverVariableValueKey = "value"
+const serverVariableReplaceKey = "replace"
+
+const actionTypeKey = "type"
+const actionUrlKey = "url"
+const actionAppendQueryStringKey = "append_query_string"
+const actionRedirectTypeKey = "redirect_type"
+const actionStatusCodeKey = "status_code"
+const actionSubStatusCodeKey = "sub_status_code"
+const actionReasonKey = "reason"
+const actionDescriptionKey = "description"
+
+// patternSyntaxes maps the syntax names used in configurations to the names
+// of the url rewrite api.
+var patternSyntaxes = map[string]string{
+	"ecmascript":  "regular_expression",
+	"wildcard":    "wildcard",
+	"exact_match": "exact_match",
+}
+
+// patternSyntaxName maps a syntax name of the url rewrite api back to the
+// name used in configurations.
+func patternSyntaxName(apiName string) string {
+	for name, syntax := range patternSyntaxes {
+		if syntax == apiName {
+			return name
+		}
+	}
+	return apiName
+}
+
+// rewriteMatchSchema adds the matching attributes shared by all url rewrite
+// rule resources to the given resource specific attributes.
+func rewriteMatchSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s[nameKey] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s[rulePatternKey] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s[ruleSyntaxKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "ecmascript",
+		ValidateFunc: validation.StringInSlice([]string{"ecmascript", "wildcard", "exact_match"}, false),
+	}
+	s[ruleIgnoreCaseKey] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	s[ruleNegateKey] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s[ruleStopProcessingKey] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s[ruleConditionMatchKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "match_all",
+		ValidateFunc: validation.StringInSlice([]string{"match_all", "match_any"}, false),
+	}
+	s[ruleTrackAllCapturesKey] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s[ruleConditionKey] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				conditionInputKey: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Input of the condition, e.g. {HTTP_HOST}",
+				},
+				rulePatternKey: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				ruleNegateKey: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				ruleIgnoreCaseKey: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				conditionMatchTypeKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "pattern",
+					ValidateFunc: validation.StringInSlice([]string{"pattern", "is_file", "is_directory"}, false),
+				},
+			},
+		},
+	}
+	s[rulePriorityKey] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Position of the rule within its scope, rules are evaluated in ascending order",
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	return s
+}
+
+// rewriteRuleSchema extends rewriteMatchSchema by the server variables and
+// the action of inbound and global rules.
+func rewriteRuleSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s = rewriteMatchSchema(s)
+	s[ruleServerVariableKey] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				nameKey: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				serverVariableValueKey: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				serverVariableReplaceKey: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+	s[ruleActionKey] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				actionTypeKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"none", "rewrite", "redirect", "custom_response", "abort_request"}, false),
+				},
+				actionUrlKey: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				actionAppendQueryStringKey: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				actionRedirectTypeKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "permanent",
+					ValidateFunc: validation.StringInSlice([]string{"permanent", "found", "see_other", "temporary"}, false),
+				},
+				actionStatusCodeKey: {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				actionSubStatusCodeKey: {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				actionReasonKey: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				actionDescriptionKey: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+	return s
+}
+
+func getRewriteMatch(d *schema.ResourceData) iis.RewriteMatch {
+	match := iis.RewriteMatch{
+		Name:                      d.Get(nameKey).(string),
+		Pattern:                   d.Get(rulePatternKey).(string),
+		PatternSyntax:             patternSyntaxes[d.Get(ruleSyntaxKey).(string)],
+		IgnoreCase:                d.Get(ruleIgnoreCaseKey).(bool),
+		Negate:                    d.Get(ruleNegateKey).(bool),
+		StopProcessing:            d.Get(ruleStopProcessingKey).(bool),
+		ConditionMatchConstraints: d.Get(ruleConditionMatchKey).(string),
+		TrackAllCaptures:          d.Get(ruleTrackAllCapturesKey).(bool),
+		Conditions:                []iis.RewriteCondition{},
+	}
+	for _, entry := range getList(d, ruleConditionKey) {
+		condition := entry.(map[string]interface{})
+		match.Conditions = append(match.Conditions, iis.RewriteCondition{
+			Input:      condition[conditionInputKey].(string),
+			Pattern:    condition[rulePatternKey].(string),
+			Negate:     condition[ruleNegateKey].(bool),
+			IgnoreCase: condition[ruleIgnoreCaseKey].(bool),
+			MatchType:  condition[conditionMatchTypeKey].(string),
+		})
+	}
+	if isConfigured(d, rulePriorityKey) {
+		priority := d.Get(rulePriorityKey).(int)
+		match.Priority = &priority
+	}
+	return match
+}
+
+func getRewriteRule(d *schema.ResourceData) iis.RewriteRule {
+	rule := iis.RewriteRule{
+		RewriteMatch:    getRewriteMatch(d),
+		ServerVariables: []iis.RewriteServerVariable{},
+	}
+	for _, entry := range getList(d, ruleServerVariableKey) {
+		variable := entry.(map[string]interface{})
+		rule.ServerVariables = append(rule.ServerVariables, iis.RewriteServerVariable{
+			Name:    variable[nameKey].(string),
+			Value:   variable[serverVariableValueKey].(string),
+			Replace: variable[serverVariableReplaceKey].(bool),
+		})
+	}
+	action := getNestedMap(d, ruleActionKey)
+	rule.Action = iis.RewriteAction{
+		Type:              action[actionTypeKey].(string),
+		Url:               action[actionUrlKey].(string),
+		AppendQueryString: action[actionAppendQueryStringKey].(bool),
+		RedirectType:      action[actionRedirectTypeKey].(string),
+		StatusCode:        action[actionStatusCodeKey].(int),
+		SubStatusCode:     action[actionSubStatusCodeKey].(int),
+		Reason:            action[actionReasonKey].(string),
+		Description:       action[actionDescriptionKey].(string),
+	}
+	return rule
+}
+
+func setRewriteMatch(d *schema.ResourceData, match iis.RewriteMatch) error {
+	conditions := make([]interface{}, len(match.Conditions))
+	for i, condition := range match.Conditions {
+		conditions[i] = map[string]interface{}{
+			conditionInputKey:     condition.Input,
+			rulePatternKey:        condition.Pattern,
+			ruleNegateKey:         condition.Negate,
+			ruleIgnoreCaseKey:     condition.IgnoreCase,
+			conditionMatchTypeKey: condition.MatchType,
+		}
+	}
+	values := map[string]interface{}{
+		nameKey:                 match.Name,
+		rulePatternKey:          match.Pattern,
+		ruleSyntaxKey:           patternSyntaxName(match.PatternSyntax),
+		ruleIgnoreCaseKey:       match.IgnoreCase,
+		ruleNegateKey:           match.Negate,
+		ruleStopProcessingKey:   match.StopProcessing,
+		ruleConditionMatchKey:   match.ConditionMatchConstraints,
+		ruleTrackAllCapturesKey: match.TrackAllCaptures,
+		ruleConditionKey:        conditions,
+	}
+	if match.Priority != nil {
+		values[rulePriorityKey] = *match.Priority
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setRewriteRule(d *schema.ResourceData, rule *iis.RewriteRule) error {
+	if err := setRewriteMatch(d, rule.RewriteMatch); err != nil {
+		return err
+	}
+	variables := make([]interface{}, len(rule.ServerVariables))
+	for i, variable := range rule.ServerVariables {
+		variables[i] = map[string]interface{}{
+			nameKey:                  variable.Name,
+			serverVariableValueKey:   variable.Value,
+			serverVariableReplaceKey: variable.Replace,
+		}
+	}
+	action := []interface{}{
+		map[string]interface{}{
+			actionTypeKey:              rule.Action.Type,
+			actionUrlKey:               rule.Action.Url,
+			actionAppendQueryStringKey: rule.Action.AppendQueryString,
+			actionRedirectTypeKey:      rule.Action.RedirectType,
+			actionStatusCodeKey:        rule.Action.StatusCode,
+			actionSubStatusCodeKey:     rule.Action.SubStatusCode,
+			actionReasonKey:            rule.Action.Reason,
+			actionDescriptionKey:       rule.Action.Description,
+		},
+	}
+	if err := d.Set(ruleServerVariableKey, variables); err != nil {
+		return err
+	}
+	return d.Set(ruleActionKey, action)
+}
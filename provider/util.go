@@ -2,6 +2,7 @@ package provider
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,3 +26,36 @@ func toJSON(obj interface{}) string {
 	}
 	return string(jsonBytes)
 }
+
+func toStringList(values []interface{}) []string {
+	list := make([]string, len(values))
+	for i, value := range values {
+		list[i] = value.(string)
+	}
+	return list
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isConfigured reports whether key is set in the configuration, which unlike
+// d.GetOk also holds for zero values.
+func isConfigured(d *schema.ResourceData, key string) bool {
+	return !d.GetRawConfig().GetAttr(key).IsNull()
+}
+
+// getWriteOnlyString returns the configured value of a write-only attribute,
+// which is never stored in the state and thus only found in the raw config.
+func getWriteOnlyString(d *schema.ResourceData, key string) string {
+	value := d.GetRawConfig().GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// validateWebsiteCertificates rejects https bindings whose certificate does
+// not cover the binding's hostname, or expires within the configured window
+// when the provider is set to fail on expiring certificates.
+//
+// CustomizeDiff can only fail a plan, so expiring certificates are otherwise
+// reported as warnings by certificateExpiryWarnings. For existing bindings
+// this happens while refreshing, for new or changed ones before they are
+// sent to IIS on apply. Failing to list the certificates skips the checks.
+func validateWebsiteCertificates(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(bindingsKey) {
+		return nil
+	}
+	meta := m.(*providerMeta)
+	var certificates []iis.Certificate
+	for _, binding := range getBindings(d.Get(bindingsKey).(*schema.Set)) {
+		if binding.UseCentralCertificateStore {
+			if binding.Hostname == "" {
+				return fmt.Errorf("binding %s:%d uses the central certificate store, which requires a hostname", binding.IPAddress, binding.Port)
+			}
+			if binding.Certificate != nil {
+				return fmt.Errorf("binding %s:%d:%s uses the central certificate store and cannot set a certificate thumbprint", binding.IPAddress, binding.Port, binding.Hostname)
+			}
+			continue
+		}
+		if !strings.EqualFold(binding.Protocol, "https") || binding.Certificate == nil {
+			continue
+		}
+		if certificates == nil {
+			var err error
+			if certificates, err = meta.client.ListCertificates(ctx); err != nil {
+				// The checks are advisory, so the plan goes on without them.
+				tflog.Warn(ctx, "Could not check the certificates of the website bindings: "+err.Error())
+				return nil
+			}
+		}
+		certificate := findCertificateByThumbprint(certificates, binding.Certificate.Thumbprint)
+		if certificate == nil {
+			return fmt.Errorf("certificate %s of binding %s:%d:%s not found", binding.Certificate.Thumbprint, binding.IPAddress, binding.Port, binding.Hostname)
+		}
+		if binding.Hostname != "" && !certificateCoversHost(certificate, binding.Hostname) {
+			return fmt.Errorf("certificate %s (%s) does not cover hostname %s", certificate.Thumbprint, certificate.Subject, binding.Hostname)
+		}
+		if meta.failOnCertificateExpiry && expiresWithin(certificate, meta.certificateExpiryWindow) {
+			return fmt.Errorf("certificate %s (%s) of binding %s:%d:%s expires on %s", certificate.Thumbprint, certificate.Subject,
+				binding.IPAddress, binding.Port, binding.Hostname, certificate.ValidTo.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// certificateExpiryWarnings warns about https bindings whose certificate
+// expires within the configured window. The check is advisory, so failing to
+// list the certificates is reported as a warning as well.
+func certificateExpiryWarnings(ctx context.Context, meta *providerMeta, bindings []iis.WebsiteBinding) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var certificates []iis.Certificate
+	for _, binding := range bindings {
+		if binding.Certificate == nil || binding.Certificate.Thumbprint == "" {
+			continue
+		}
+		if certificates == nil {
+			var err error
+			if certificates, err = meta.client.ListCertificates(ctx); err != nil {
+				return append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Could not check the expiry of binding certificates",
+					Detail:   err.Error(),
+				})
+			}
+		}
+		certificate := findCertificateByThumbprint(certificates, binding.Certificate.Thumbprint)
+		if certificate == nil || !expiresWithin(certificate, meta.certificateExpiryWindow) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Certificate of binding %s:%d:%s expires soon", binding.IPAddress, binding.Port, binding.Hostname),
+			Detail: fmt.Sprintf("Certificate %s (%s) expires on %s.", certificate.Thumbprint, certificate.Subject,
+				certificate.ValidTo.Format(time.RFC3339)),
+		})
+	}
+	return diags
+}
+
+// resolveBindingCertificates looks up the ids of the certificates bindings
+// refer to by thumbprint, as IIS expects certificates to be passed by id.
+func resolveBindingCertificates(ctx context.Context, client *iis.Client, bindings []iis.WebsiteBinding) error {
+	var certificates []iis.Certificate
+	for _, binding := range bindings {
+		if binding.Certificate == nil || binding.Certificate.Thumbprint == "" {
+			continue
+		}
+		if certificates == nil {
+			var err error
+			if certificates, err = client.ListCertificates(ctx); err != nil {
+				return err
+			}
+		}
+		certificate := findCertificateByThumbprint(certificates, binding.Certificate.Thumbprint)
+		if certificate == nil {
+			return fmt.Errorf("certificate %s not found", binding.Certificate.Thumbprint)
+		}
+		binding.Certificate.ID = certificate.ID
+	}
+	return nil
+}
+
+func findCertificateByThumbprint(certificates []iis.Certificate, thumbprint string) *iis.Certificate {
+	for i, certificate := range certificates {
+		if normalizeThumbprint(certificate.Thumbprint) == normalizeThumbprint(thumbprint) {
+			return &certificates[i]
+		}
+	}
+	return nil
+}
+
+func expiresWithin(certificate *iis.Certificate, window time.Duration) bool {
+	return certificate.ValidTo.Before(time.Now().Add(window))
+}
+
+// certificateCoversHost reports whether host matches the common name or one
+// of the subject alternative names of the certificate, including wildcards.
+func certificateCoversHost(certificate *iis.Certificate, host string) bool {
+	var names []string
+	for _, part := range strings.Split(certificate.Subject, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) > 3 && strings.EqualFold(part[:3], "CN=") {
+			names = append(names, part[3:])
+		}
+	}
+	for _, name := range certificate.SubjectAlternativeNames {
+		for _, prefix := range []string{"DNS Name=", "DNS:", "DNS="} {
+			name = strings.TrimPrefix(name, prefix)
+		}
+		names = append(names, strings.TrimSpace(name))
+	}
+	for _, name := range names {
+		if strings.EqualFold(name, host) {
+			return true
+		}
+		if strings.HasPrefix(name, "*.") {
+			if i := strings.Index(host, "."); i > 0 && strings.EqualFold(name[1:], host[i:]) {
+				return true
+			}
+		}
+	}
+	return false
+}